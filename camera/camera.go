@@ -0,0 +1,117 @@
+package camera
+
+import (
+	"math"
+
+	"github.com/riavalon/ray_tracer/canvas"
+	"github.com/riavalon/ray_tracer/matrix"
+	"github.com/riavalon/ray_tracer/rays"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+	"github.com/riavalon/ray_tracer/world"
+)
+
+// Camera struct describes a pinhole camera that maps the canvas onto a
+// view of the world. The size and field of view can only be set through
+// NewCamera, since the pixel size is derived from them.
+type Camera struct {
+	hsize       int
+	vsize       int
+	fieldOfView float64
+	transform   matrix.Matrix
+	inverse     matrix.Matrix
+	halfWidth   float64
+	halfHeight  float64
+	pixelSize   float64
+}
+
+// HSize returns the horizontal size of the canvas in pixels.
+func (c *Camera) HSize() int {
+	return c.hsize
+}
+
+// VSize returns the vertical size of the canvas in pixels.
+func (c *Camera) VSize() int {
+	return c.vsize
+}
+
+// FieldOfView returns the angle in radians that the camera can see.
+func (c *Camera) FieldOfView() float64 {
+	return c.fieldOfView
+}
+
+// Transform returns the view transform of the camera.
+func (c *Camera) Transform() matrix.Matrix {
+	return c.transform
+}
+
+// SetTransform updates the view transform of the camera, usually built
+// with matrix.ViewTransform. Returns matrix.ErrNotInvertible if the
+// transform cannot be inverted, leaving the existing transform in place.
+func (c *Camera) SetTransform(m matrix.Matrix) error {
+	inverse, err := m.Inverse()
+	if err != nil {
+		return err
+	}
+	c.transform = m
+	c.inverse = inverse
+	return nil
+}
+
+// PixelSize returns the size of a single pixel on the canvas, measured in
+// world space units one unit in front of the camera.
+func (c *Camera) PixelSize() float64 {
+	return c.pixelSize
+}
+
+// RayForPixel creates a ray starting at the camera that passes through the
+// centre of the given pixel on the canvas.
+func (c *Camera) RayForPixel(px, py int) rays.Ray {
+	xOffset := (float64(px) + 0.5) * c.pixelSize
+	yOffset := (float64(py) + 0.5) * c.pixelSize
+
+	// The camera looks towards -z, so +x is to the left
+	worldX := c.halfWidth - xOffset
+	worldY := c.halfHeight - yOffset
+
+	pixel := c.inverse.MultiplyPoint(tuples.NewPoint(worldX, worldY, -1))
+	origin := c.inverse.MultiplyPoint(tuples.NewPoint(0, 0, 0))
+	direction := pixel.Subtract(origin).Normalize()
+	return rays.NewRay(origin, direction)
+}
+
+// Render casts a ray through every pixel of the camera into the world,
+// writing the resulting colours to a new canvas.
+func (c *Camera) Render(w world.World) canvas.Canvas {
+	image := canvas.NewCanvas(c.hsize, c.vsize)
+	for y := 0; y < c.vsize; y++ {
+		for x := 0; x < c.hsize; x++ {
+			image.WritePixel(x, y, w.ColourAt(c.RayForPixel(x, y)))
+		}
+	}
+	return image
+}
+
+// NewCamera creates a camera with the given canvas size and field of view,
+// using the identity matrix as its transform.
+func NewCamera(hsize, vsize int, fieldOfView float64) *Camera {
+	c := &Camera{
+		hsize:       hsize,
+		vsize:       vsize,
+		fieldOfView: fieldOfView,
+		transform:   matrix.Identity(),
+		inverse:     matrix.Identity(),
+	}
+
+	halfView := math.Tan(fieldOfView / 2)
+	aspect := float64(hsize) / float64(vsize)
+	if aspect >= 1 {
+		c.halfWidth = halfView
+		c.halfHeight = halfView / aspect
+	} else {
+		c.halfWidth = halfView * aspect
+		c.halfHeight = halfView
+	}
+	c.pixelSize = (c.halfWidth * 2) / float64(hsize)
+
+	return c
+}
@@ -0,0 +1,119 @@
+package camera
+
+import (
+	"math"
+	"testing"
+
+	"github.com/riavalon/ray_tracer/canvas"
+	"github.com/riavalon/ray_tracer/lights"
+	"github.com/riavalon/ray_tracer/matrix"
+	"github.com/riavalon/ray_tracer/shapes"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+	"github.com/riavalon/ray_tracer/world"
+)
+
+func TestNewCamera(t *testing.T) {
+	c := NewCamera(160, 120, math.Pi/2)
+
+	if c.HSize() != 160 || c.VSize() != 120 {
+		t.Errorf("Camera should have init size. Got %vx%v; Want %vx%v", c.HSize(), c.VSize(), 160, 120)
+	}
+
+	if c.FieldOfView() != math.Pi/2 {
+		t.Errorf("Camera should have init field of view. Got %v; Want %v", c.FieldOfView(), math.Pi/2)
+	}
+
+	if c.Transform().IsEquivalentTo(matrix.Identity()) == false {
+		t.Errorf("Camera should default to the identity transform. Got %v", c.Transform())
+	}
+}
+
+func TestPixelSizeForHorizontalCanvas(t *testing.T) {
+	c := NewCamera(200, 125, math.Pi/2)
+
+	if tuples.Equals(c.PixelSize(), 0.01) == false {
+		t.Errorf("Expected pixel size for horizontal canvas. Got %v; Want %v", c.PixelSize(), 0.01)
+	}
+}
+
+func TestPixelSizeForVerticalCanvas(t *testing.T) {
+	c := NewCamera(125, 200, math.Pi/2)
+
+	if tuples.Equals(c.PixelSize(), 0.01) == false {
+		t.Errorf("Expected pixel size for vertical canvas. Got %v; Want %v", c.PixelSize(), 0.01)
+	}
+}
+
+func TestRayThroughCentreOfCanvas(t *testing.T) {
+	c := NewCamera(201, 101, math.Pi/2)
+	r := c.RayForPixel(100, 50)
+
+	if want := tuples.NewPoint(0, 0, 0); r.Origin.IsEquivalentTo(want) == false {
+		t.Errorf("Expected ray origin at camera. Got %v; Want %v", r.Origin, want)
+	}
+
+	if want := tuples.NewVector(0, 0, -1); r.Direction.IsEquivalentTo(want) == false {
+		t.Errorf("Expected ray direction straight ahead. Got %v; Want %v", r.Direction, want)
+	}
+}
+
+func TestRayThroughCornerOfCanvas(t *testing.T) {
+	c := NewCamera(201, 101, math.Pi/2)
+	r := c.RayForPixel(0, 0)
+
+	if want := tuples.NewPoint(0, 0, 0); r.Origin.IsEquivalentTo(want) == false {
+		t.Errorf("Expected ray origin at camera. Got %v; Want %v", r.Origin, want)
+	}
+
+	if want := tuples.NewVector(0.66519, 0.33259, -0.66851); r.Direction.IsEquivalentTo(want) == false {
+		t.Errorf("Expected ray direction towards corner. Got %v; Want %v", r.Direction, want)
+	}
+}
+
+func TestRayWhenCameraIsTransformed(t *testing.T) {
+	c := NewCamera(201, 101, math.Pi/2)
+	c.SetTransform(matrix.Identity().Translate(0, -2, 5).RotateY(math.Pi / 4))
+	r := c.RayForPixel(100, 50)
+
+	if want := tuples.NewPoint(0, 2, -5); r.Origin.IsEquivalentTo(want) == false {
+		t.Errorf("Expected ray origin at transformed camera. Got %v; Want %v", r.Origin, want)
+	}
+
+	if want := tuples.NewVector(math.Sqrt2/2, 0, -math.Sqrt2/2); r.Direction.IsEquivalentTo(want) == false {
+		t.Errorf("Expected transformed ray direction. Got %v; Want %v", r.Direction, want)
+	}
+}
+
+func TestRenderWorldWithCamera(t *testing.T) {
+	outer := shapes.NewSphere()
+	m := outer.Material()
+	m.Colour = canvas.NewColour(0.8, 1.0, 0.6)
+	m.Diffuse = 0.7
+	m.Specular = 0.2
+	outer.SetMaterial(m)
+
+	inner := shapes.NewSphere()
+	inner.SetTransform(matrix.Scaling(0.5, 0.5, 0.5))
+
+	w := world.NewWorld()
+	w.Objects = []shapes.Shape{outer, inner}
+	w.Lights = []lights.PointLight{
+		lights.NewPointLight(tuples.NewPoint(-10, 10, -10), canvas.NewColour(1, 1, 1)),
+	}
+
+	c := NewCamera(11, 11, math.Pi/2)
+	view, _ := matrix.ViewTransform(
+		tuples.CreatePoint(0, 0, -5),
+		tuples.CreatePoint(0, 0, 0),
+		tuples.CreateVector(0, 1, 0),
+	)
+	c.SetTransform(view)
+
+	image := c.Render(w)
+	got, _ := image.GetPixel(5, 5)
+	want := canvas.NewColour(0.38066, 0.47583, 0.2855)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected rendered pixel at centre of canvas.\nGot  %v;\nWant %v;", got, want)
+	}
+}
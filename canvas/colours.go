@@ -20,9 +20,9 @@ type Colour struct {
 // with that scale and return the new converted colour.
 func (c Colour) ScaleWithMaxRange(m float64) Colour {
 	return NewColour(
-		math.Max(0, math.Min(m, math.Round(tup.Calculate(m, c.Red, tup.Multiply)))),
-		math.Max(0, math.Min(m, math.Round(tup.Calculate(m, c.Green, tup.Multiply)))),
-		math.Max(0, math.Min(m, math.Round(tup.Calculate(m, c.Blue, tup.Multiply)))),
+		math.Max(0, math.Min(m, math.Round(m*c.Red))),
+		math.Max(0, math.Min(m, math.Round(m*c.Green))),
+		math.Max(0, math.Min(m, math.Round(m*c.Blue))),
 	)
 }
 
@@ -31,9 +31,9 @@ func (c Colour) ScaleWithMaxRange(m float64) Colour {
 // colour struct.
 func (c Colour) Subtract(c2 Colour) Colour {
 	return NewColour(
-		tup.Calculate(c.Red, c2.Red, tup.Subtract),
-		tup.Calculate(c.Green, c2.Green, tup.Subtract),
-		tup.Calculate(c.Blue, c2.Blue, tup.Subtract),
+		c.Red-c2.Red,
+		c.Green-c2.Green,
+		c.Blue-c2.Blue,
 	)
 }
 
@@ -41,9 +41,9 @@ func (c Colour) Subtract(c2 Colour) Colour {
 // colour struct. Returns sum total colour
 func (c Colour) Add(c2 Colour) Colour {
 	return NewColour(
-		tup.Calculate(c.Red, c2.Red, tup.Addition),
-		tup.Calculate(c.Green, c2.Green, tup.Addition),
-		tup.Calculate(c.Blue, c2.Blue, tup.Addition),
+		c.Red+c2.Red,
+		c.Green+c2.Green,
+		c.Blue+c2.Blue,
 	)
 }
 
@@ -51,9 +51,9 @@ func (c Colour) Add(c2 Colour) Colour {
 // scalar value, returning the resulting colour.
 func (c Colour) MultiplyByScalar(scalar float64) Colour {
 	return NewColour(
-		tup.Calculate(c.Red, scalar, tup.Multiply),
-		tup.Calculate(c.Green, scalar, tup.Multiply),
-		tup.Calculate(c.Blue, scalar, tup.Multiply),
+		c.Red*scalar,
+		c.Green*scalar,
+		c.Blue*scalar,
 	)
 }
 
@@ -61,9 +61,9 @@ func (c Colour) MultiplyByScalar(scalar float64) Colour {
 // given scalar value, returning the resulting colour.
 func (c Colour) DivideByScalar(scalar float64) Colour {
 	return NewColour(
-		tup.Calculate(c.Red, scalar, tup.Divide),
-		tup.Calculate(c.Green, scalar, tup.Divide),
-		tup.Calculate(c.Blue, scalar, tup.Divide),
+		c.Red/scalar,
+		c.Green/scalar,
+		c.Blue/scalar,
 	)
 }
 
@@ -99,8 +99,8 @@ func NewColour(r, g, b float64) Colour {
 // colour of multiplying each component together.
 func MultiplyColours(c1, c2 Colour) Colour {
 	return NewColour(
-		tup.Calculate(c1.Red, c2.Red, tup.Multiply),
-		tup.Calculate(c1.Green, c2.Green, tup.Multiply),
-		tup.Calculate(c1.Blue, c2.Blue, tup.Multiply),
+		c1.Red*c2.Red,
+		c1.Green*c2.Green,
+		c1.Blue*c2.Blue,
 	)
 }
@@ -0,0 +1,59 @@
+package lights
+
+import (
+	"math"
+
+	"github.com/riavalon/ray_tracer/canvas"
+	"github.com/riavalon/ray_tracer/materials"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+// PointLight struct represents a light source with no size that shines
+// equally in every direction from a single point.
+type PointLight struct {
+	Position  tuples.Point
+	Intensity canvas.Colour
+}
+
+// NewPointLight creates a point light at the given position with the
+// given colour as its intensity.
+func NewPointLight(position tuples.Point, intensity canvas.Colour) PointLight {
+	return PointLight{
+		Position:  position,
+		Intensity: intensity,
+	}
+}
+
+// Lighting shades a point on a surface using the Phong reflection model.
+// The eye and normal vectors are expected to be normalized. Returns the
+// sum of the ambient, diffuse and specular contributions of the light.
+// Points in shadow only receive the ambient contribution.
+func Lighting(m materials.Material, light PointLight, point tuples.Point, eye, normal tuples.Vector, inShadow bool) canvas.Colour {
+	effectiveColour := canvas.MultiplyColours(m.Colour, light.Intensity)
+	ambient := effectiveColour.MultiplyByScalar(m.Ambient)
+	if inShadow {
+		return ambient
+	}
+
+	lightVector := light.Position.Subtract(point).Normalize()
+
+	black := canvas.NewColour(0, 0, 0)
+	diffuse, specular := black, black
+
+	// A negative dot product means the light is on the other side of the
+	// surface, leaving only ambient light
+	lightDotNormal := lightVector.Dot(normal)
+	if lightDotNormal >= 0 {
+		diffuse = effectiveColour.MultiplyByScalar(m.Diffuse * lightDotNormal)
+
+		// A negative dot product here means the light reflects away from the eye
+		reflectVector := lightVector.Negate().Reflect(normal)
+		reflectDotEye := reflectVector.Dot(eye)
+		if reflectDotEye > 0 {
+			factor := math.Pow(reflectDotEye, m.Shininess)
+			specular = light.Intensity.MultiplyByScalar(m.Specular * factor)
+		}
+	}
+
+	return ambient.Add(diffuse).Add(specular)
+}
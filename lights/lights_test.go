@@ -0,0 +1,108 @@
+package lights
+
+import (
+	"math"
+	"testing"
+
+	"github.com/riavalon/ray_tracer/canvas"
+	"github.com/riavalon/ray_tracer/materials"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+func TestNewPointLight(t *testing.T) {
+	position := tuples.NewPoint(0, 0, 0)
+	intensity := canvas.NewColour(1, 1, 1)
+	light := NewPointLight(position, intensity)
+
+	if light.Position.IsEquivalentTo(position) == false {
+		t.Errorf("Point light should have init position. Got %v; Want %v", light.Position, position)
+	}
+
+	if light.Intensity.IsEquivalentTo(intensity) == false {
+		t.Errorf("Point light should have init intensity. Got %v; Want %v", light.Intensity, intensity)
+	}
+}
+
+func TestLightingWithEyeBetweenLightAndSurface(t *testing.T) {
+	m := materials.NewMaterial()
+	position := tuples.NewPoint(0, 0, 0)
+	eye := tuples.NewVector(0, 0, -1)
+	normal := tuples.NewVector(0, 0, -1)
+	light := NewPointLight(tuples.NewPoint(0, 0, -10), canvas.NewColour(1, 1, 1))
+	got := Lighting(m, light, position, eye, normal, false)
+	want := canvas.NewColour(1.9, 1.9, 1.9)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected full ambient, diffuse and specular.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestLightingWithEyeOffset45Degrees(t *testing.T) {
+	m := materials.NewMaterial()
+	position := tuples.NewPoint(0, 0, 0)
+	eye := tuples.NewVector(0, math.Sqrt2/2, -math.Sqrt2/2)
+	normal := tuples.NewVector(0, 0, -1)
+	light := NewPointLight(tuples.NewPoint(0, 0, -10), canvas.NewColour(1, 1, 1))
+	got := Lighting(m, light, position, eye, normal, false)
+	want := canvas.NewColour(1.0, 1.0, 1.0)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected specular to fall off with offset eye.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestLightingWithLightOffset45Degrees(t *testing.T) {
+	m := materials.NewMaterial()
+	position := tuples.NewPoint(0, 0, 0)
+	eye := tuples.NewVector(0, 0, -1)
+	normal := tuples.NewVector(0, 0, -1)
+	light := NewPointLight(tuples.NewPoint(0, 10, -10), canvas.NewColour(1, 1, 1))
+	got := Lighting(m, light, position, eye, normal, false)
+	want := canvas.NewColour(0.7364, 0.7364, 0.7364)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected diffuse to fall off with offset light.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestLightingWithEyeInPathOfReflection(t *testing.T) {
+	m := materials.NewMaterial()
+	position := tuples.NewPoint(0, 0, 0)
+	eye := tuples.NewVector(0, -math.Sqrt2/2, -math.Sqrt2/2)
+	normal := tuples.NewVector(0, 0, -1)
+	light := NewPointLight(tuples.NewPoint(0, 10, -10), canvas.NewColour(1, 1, 1))
+	got := Lighting(m, light, position, eye, normal, false)
+	want := canvas.NewColour(1.6364, 1.6364, 1.6364)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected full specular with eye in reflection path.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestLightingWithLightBehindSurface(t *testing.T) {
+	m := materials.NewMaterial()
+	position := tuples.NewPoint(0, 0, 0)
+	eye := tuples.NewVector(0, 0, -1)
+	normal := tuples.NewVector(0, 0, -1)
+	light := NewPointLight(tuples.NewPoint(0, 0, 10), canvas.NewColour(1, 1, 1))
+	got := Lighting(m, light, position, eye, normal, false)
+	want := canvas.NewColour(0.1, 0.1, 0.1)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected only ambient with light behind surface.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestLightingWithSurfaceInShadow(t *testing.T) {
+	m := materials.NewMaterial()
+	position := tuples.NewPoint(0, 0, 0)
+	eye := tuples.NewVector(0, 0, -1)
+	normal := tuples.NewVector(0, 0, -1)
+	light := NewPointLight(tuples.NewPoint(0, 0, -10), canvas.NewColour(1, 1, 1))
+	got := Lighting(m, light, position, eye, normal, true)
+	want := canvas.NewColour(0.1, 0.1, 0.1)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected only ambient with surface in shadow.\nGot  %v;\nWant %v;", got, want)
+	}
+}
@@ -9,13 +9,13 @@ import (
 )
 
 type environment struct {
-	wind    *tuples.Tuple
-	gravity *tuples.Tuple
+	wind    tuples.Tuple
+	gravity tuples.Tuple
 }
 
 type projectile struct {
-	position *tuples.Tuple
-	velocity *tuples.Tuple
+	position tuples.Tuple
+	velocity tuples.Tuple
 	colour   canvas.Colour
 }
 
@@ -35,7 +35,7 @@ func main() {
 
 	p := projectile{
 		position: tuples.CreatePoint(0, 1, 0),
-		velocity: tuples.NormalizeVector(tuples.CreateVector(1, 1, 0)).MultiplyByScalar(9.95),
+		velocity: tuples.NewVector(1, 1, 0).Normalize().MultiplyByScalar(9.95).ToTuple(),
 		colour:   canvas.NewColour(1, 0, 0),
 	}
 	initProj := tick(env, p)
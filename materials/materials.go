@@ -0,0 +1,29 @@
+package materials
+
+import "github.com/riavalon/ray_tracer/canvas"
+
+// Material struct describes how the surface of a shape looks using the
+// attributes of the Phong reflection model. Ambient, Diffuse and Specular
+// are typically between 0 and 1. Higher Shininess values give smaller,
+// tighter specular highlights. Shapes with NoShadow set are ignored when
+// checking if a point is in shadow.
+type Material struct {
+	Colour    canvas.Colour
+	Ambient   float64
+	Diffuse   float64
+	Specular  float64
+	Shininess float64
+	NoShadow  bool
+}
+
+// NewMaterial creates the default material, which is a plain white surface
+// with a bright, tight highlight.
+func NewMaterial() Material {
+	return Material{
+		Colour:    canvas.NewColour(1, 1, 1),
+		Ambient:   0.1,
+		Diffuse:   0.9,
+		Specular:  0.9,
+		Shininess: 200,
+	}
+}
@@ -0,0 +1,36 @@
+package materials
+
+import (
+	"testing"
+
+	"github.com/riavalon/ray_tracer/canvas"
+)
+
+func TestDefaultMaterial(t *testing.T) {
+	m := NewMaterial()
+	want := canvas.NewColour(1, 1, 1)
+
+	if m.Colour.IsEquivalentTo(want) == false {
+		t.Errorf("Default material should be white. Got %v; Want %v", m.Colour, want)
+	}
+
+	if m.Ambient != 0.1 {
+		t.Errorf("Default material should have ambient. Got %v; Want %v", m.Ambient, 0.1)
+	}
+
+	if m.Diffuse != 0.9 {
+		t.Errorf("Default material should have diffuse. Got %v; Want %v", m.Diffuse, 0.9)
+	}
+
+	if m.Specular != 0.9 {
+		t.Errorf("Default material should have specular. Got %v; Want %v", m.Specular, 0.9)
+	}
+
+	if m.Shininess != 200 {
+		t.Errorf("Default material should have shininess. Got %v; Want %v", m.Shininess, 200)
+	}
+
+	if m.NoShadow {
+		t.Errorf("Default material should cast shadows")
+	}
+}
@@ -1,30 +1,61 @@
 package matrix
 
-// Matrix struct represents a 4x4 matrix of numbers.
+import (
+	"errors"
+	"fmt"
+
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+// Matrix struct represents a grid of numbers with any number of rows and
+// columns. 4x4 matrices are the most common and take a faster path through
+// multiplication, determinants and inversion.
 type Matrix struct {
 	grid [][]float64
 }
 
+// ErrOutOfRange is returned when trying to access a row or column that
+// does not exist in the matrix.
+var ErrOutOfRange = errors.New("row or column is out of range for matrix")
+
+// Rows returns the number of rows in the matrix.
+func (m Matrix) Rows() int {
+	return len(m.grid)
+}
+
+// Cols returns the number of columns in the matrix.
+func (m Matrix) Cols() int {
+	if len(m.grid) == 0 {
+		return 0
+	}
+	return len(m.grid[0])
+}
+
 // Get will take a row and col argument and return the value held at that
-// position in the matrix.
-func (m Matrix) Get(row, col int) float64 {
-	return m.grid[row][col]
+// position in the matrix. Returns ErrOutOfRange if the position is not
+// within the matrix.
+func (m Matrix) Get(row, col int) (float64, error) {
+	if !m.inRange(row, col) {
+		return 0, ErrOutOfRange
+	}
+	return m.grid[row][col], nil
 }
 
 // Set will take a row and column argument as well as a new value and update
-// the item at that coordinate in the matrix with the given value. If index
-// is out of bounds, nothing happens.
-func (m *Matrix) Set(row, col int, value float64) {
-	if row < 0 || row > 3 || col < 0 || col > 3 {
-		return
+// the item at that coordinate in the matrix with the given value. Returns
+// ErrOutOfRange if the position is not within the matrix.
+func (m *Matrix) Set(row, col int, value float64) error {
+	if !m.inRange(row, col) {
+		return ErrOutOfRange
 	}
 	m.grid[row][col] = value
+	return nil
 }
 
 // SeedMatrix takes a variadic param of float64s to populate
 // into the grid going from top to bottom, left to right. Default or
 // existing values are left in pace if not enough arguments are given.
-// If > 16 arguments are given, the rest after the 16th will be ignored.
+// If more arguments are given than there are cells, the rest are ignored.
 func (m *Matrix) SeedMatrix(items ...float64) {
 outerLoop:
 	for y, row := range m.grid {
@@ -39,27 +70,356 @@ outerLoop:
 	}
 }
 
-// NewMatrix creates a new matrix struct with provided values squentially.
-// Values are set from top-down, left-right.
+// Multiply takes another matrix and returns the product of the calling
+// matrix and the given matrix as a new matrix. Neither operand is modified.
+// Panics if the number of columns in the calling matrix does not match the
+// number of rows in the given matrix.
+func (m Matrix) Multiply(b Matrix) Matrix {
+	if m.Cols() != b.Rows() {
+		panic(fmt.Sprintf("Cannot multiply %dx%d matrix by %dx%d matrix", m.Rows(), m.Cols(), b.Rows(), b.Cols()))
+	}
+
+	if m.is4x4() && b.is4x4() {
+		return multiply4x4(m, b)
+	}
+
+	result := NewMatrix(m.Rows(), b.Cols())
+	for row := range result.grid {
+		for col := range result.grid[row] {
+			var total float64
+			for i := range m.grid[row] {
+				total += m.grid[row][i] * b.grid[i][col]
+			}
+			result.grid[row][col] = total
+		}
+	}
+	return result
+}
+
+// MultiplyTuple multiplies the matrix by the given tuple, treating the tuple
+// as a single column matrix. Returns the resulting tuple. Panics if the
+// matrix is not 4x4.
+func (m Matrix) MultiplyTuple(t tuples.Tuple) tuples.Tuple {
+	if !m.is4x4() {
+		panic(fmt.Sprintf("Cannot multiply %dx%d matrix by a tuple", m.Rows(), m.Cols()))
+	}
+
+	g := m.grid
+	return tuples.CreateTuple(
+		g[0][0]*t.X+g[0][1]*t.Y+g[0][2]*t.Z+g[0][3]*t.W,
+		g[1][0]*t.X+g[1][1]*t.Y+g[1][2]*t.Z+g[1][3]*t.W,
+		g[2][0]*t.X+g[2][1]*t.Y+g[2][2]*t.Z+g[2][3]*t.W,
+		g[3][0]*t.X+g[3][1]*t.Y+g[3][2]*t.Z+g[3][3]*t.W,
+	)
+}
+
+// MultiplyPoint multiplies the matrix by the given point, returning the
+// transformed point. The w component of the result is ignored.
+func (m Matrix) MultiplyPoint(p tuples.Point) tuples.Point {
+	t := m.MultiplyTuple(p.ToTuple())
+	return tuples.NewPoint(t.X, t.Y, t.Z)
+}
+
+// MultiplyVector multiplies the matrix by the given vector, returning the
+// transformed vector. Translations have no effect on vectors. The w
+// component of the result is ignored.
+func (m Matrix) MultiplyVector(v tuples.Vector) tuples.Vector {
+	t := m.MultiplyTuple(v.ToTuple())
+	return tuples.NewVector(t.X, t.Y, t.Z)
+}
+
+// IsEquivalentTo checks if every value in the calling matrix is equivalent
+// to the value at the same position in the given matrix. Values are compared
+// using tuples.Equals so small floating point differences are ignored.
+// Matrices with different dimensions are never equivalent.
+func (m Matrix) IsEquivalentTo(b Matrix) bool {
+	if m.Rows() != b.Rows() || m.Cols() != b.Cols() {
+		return false
+	}
+
+	for row := range m.grid {
+		for col := range m.grid[row] {
+			if !tuples.Equals(m.grid[row][col], b.grid[row][col]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Transpose flips the matrix over its diagonal so that the rows of the
+// calling matrix become the columns of the returned matrix.
+func (m Matrix) Transpose() Matrix {
+	result := NewMatrix(m.Cols(), m.Rows())
+	for row := range m.grid {
+		for col := range m.grid[row] {
+			result.grid[col][row] = m.grid[row][col]
+		}
+	}
+	return result
+}
+
+// Submatrix returns a copy of the matrix with the given row and column
+// removed. A 4x4 matrix produces a 3x3 submatrix, a 3x3 produces a 2x2.
+// Returns ErrOutOfRange if the row or column is not within the matrix.
+func (m Matrix) Submatrix(row, col int) (Matrix, error) {
+	if !m.inRange(row, col) {
+		return Matrix{}, ErrOutOfRange
+	}
+	return m.submatrix(row, col), nil
+}
+
+// Minor gets the determinant of the submatrix at the given row and column.
+// Returns ErrNotSquare if the matrix is not square, and ErrOutOfRange if
+// the row or column is not within the matrix. A 1x1 matrix has no minors,
+// so every position is out of range.
+func (m Matrix) Minor(row, col int) (float64, error) {
+	if err := m.checkMinor(row, col); err != nil {
+		return 0, err
+	}
+	return m.minor(row, col), nil
+}
+
+// Cofactor gets the minor at the given row and column, negating it when
+// row + col is odd. Returns the same errors as Minor.
+func (m Matrix) Cofactor(row, col int) (float64, error) {
+	if err := m.checkMinor(row, col); err != nil {
+		return 0, err
+	}
+	return m.cofactor(row, col), nil
+}
+
+// IsSquare checks if the matrix has the same number of rows and columns.
+// An empty matrix is not considered square.
+func (m Matrix) IsSquare() bool {
+	return m.Rows() > 0 && m.Rows() == m.Cols()
+}
+
+// Determinant calculates the determinant of the matrix. 2x2 matrices are
+// calculated directly, larger matrices are expanded along the first row
+// using the cofactors of each column. Panics if the matrix is not square,
+// use IsSquare to check first.
+func (m Matrix) Determinant() float64 {
+	if !m.IsSquare() {
+		panic(fmt.Sprintf("Cannot get the determinant of a %dx%d matrix", m.Rows(), m.Cols()))
+	}
+
+	switch {
+	case len(m.grid) == 1:
+		return m.grid[0][0]
+	case len(m.grid) == 2:
+		return m.grid[0][0]*m.grid[1][1] - m.grid[0][1]*m.grid[1][0]
+	case m.is4x4():
+		return determinant4x4(m)
+	}
+
+	var det float64
+	for col, value := range m.grid[0] {
+		det += value * m.cofactor(0, col)
+	}
+	return det
+}
+
+// IsInvertible checks if the matrix can be inverted. Matrices that are not
+// square, or have a determinant of zero, cannot be inverted.
+func (m Matrix) IsInvertible() bool {
+	return m.IsSquare() && m.Determinant() != 0
+}
+
+// ErrNotInvertible is returned when trying to invert a matrix that has
+// a determinant of zero.
+var ErrNotInvertible = errors.New("matrix is not invertible")
+
+// ErrNotSquare is returned when trying to invert a matrix that does not
+// have the same number of rows and columns.
+var ErrNotSquare = errors.New("matrix is not square")
+
+// Inverse calculates the inverse of the matrix, such that multiplying a
+// matrix by its inverse gives the identity matrix. Returns ErrNotSquare if
+// the matrix is not square, and ErrNotInvertible if the matrix has a
+// determinant of zero.
+func (m Matrix) Inverse() (Matrix, error) {
+	if !m.IsSquare() {
+		return Matrix{}, ErrNotSquare
+	}
+
+	if m.is4x4() {
+		return inverse4x4(m)
+	}
+
+	det := m.Determinant()
+	if det == 0 {
+		return Matrix{}, ErrNotInvertible
+	}
+
+	result := NewMatrix(m.Rows(), m.Cols())
+
+	// Writing to [col][row] transposes the cofactor matrix as we go
+	for row := range m.grid {
+		for col := range m.grid[row] {
+			result.grid[col][row] = m.cofactor(row, col) / det
+		}
+	}
+	return result, nil
+}
+
+// Identity creates a new 4x4 identity matrix. Multiplying any matrix or
+// tuple by the identity matrix returns the original value.
+func Identity() Matrix {
+	return NewMatrix(4, 4,
+		1, 0, 0, 0,
+		0, 1, 0, 0,
+		0, 0, 1, 0,
+		0, 0, 0, 1,
+	)
+}
+
+// NewMatrix creates a new matrix with the given number of rows and columns,
+// populated with the provided values squentially. Values are set from
+// top-down, left-right. Missing values default to zero.
 // e.g
-// NewMatrix(1, 2, 3, 4, 5, 6, 7, 8 ...) =>
+// NewMatrix(2, 4, 1, 2, 3, 4, 5, 6, 7, 8) =>
 // [
 //		[1, 2, 3, 4],
 //		[5, 6, 7, 8],
-//		...
 // ]
-func NewMatrix(values ...float64) Matrix {
+func NewMatrix(rows, cols int, values ...float64) Matrix {
 	m := Matrix{}
-	m.grid = buildEmptyMatrix()
+	m.grid = buildEmptyMatrix(rows, cols)
 	m.SeedMatrix(values...)
 	return m
 }
 
-func buildEmptyMatrix() [][]float64 {
-	matrix := make([][]float64, 4)
+func buildEmptyMatrix(rows, cols int) [][]float64 {
+	// Back every row with one slice so the cells sit next to each other
+	cells := make([]float64, rows*cols)
+	matrix := make([][]float64, rows)
 	for i := range matrix {
-		row := make([]float64, 4)
-		matrix[i] = row
+		matrix[i] = cells[i*cols : (i+1)*cols : (i+1)*cols]
 	}
 	return matrix
 }
+
+func (m Matrix) inRange(row, col int) bool {
+	return row >= 0 && row < m.Rows() && col >= 0 && col < m.Cols()
+}
+
+func (m Matrix) checkMinor(row, col int) error {
+	if !m.IsSquare() {
+		return ErrNotSquare
+	}
+
+	if m.Rows() < 2 || !m.inRange(row, col) {
+		return ErrOutOfRange
+	}
+	return nil
+}
+
+// submatrix, minor and cofactor skip the checks done by their exported
+// versions, so they are only called with positions known to be valid.
+func (m Matrix) submatrix(row, col int) Matrix {
+	grid := make([][]float64, 0, len(m.grid)-1)
+	for y, cells := range m.grid {
+		if y == row {
+			continue
+		}
+		newRow := make([]float64, 0, len(cells)-1)
+		for x, cell := range cells {
+			if x == col {
+				continue
+			}
+			newRow = append(newRow, cell)
+		}
+		grid = append(grid, newRow)
+	}
+	return Matrix{grid: grid}
+}
+
+func (m Matrix) minor(row, col int) float64 {
+	return m.submatrix(row, col).Determinant()
+}
+
+func (m Matrix) cofactor(row, col int) float64 {
+	minor := m.minor(row, col)
+	if (row+col)%2 != 0 {
+		return -minor
+	}
+	return minor
+}
+
+func (m Matrix) is4x4() bool {
+	return m.Rows() == 4 && m.Cols() == 4
+}
+
+func multiply4x4(m, b Matrix) Matrix {
+	result := NewMatrix(4, 4)
+	a, g := m.grid, b.grid
+	for row := 0; row < 4; row++ {
+		r := a[row]
+		result.grid[row][0] = r[0]*g[0][0] + r[1]*g[1][0] + r[2]*g[2][0] + r[3]*g[3][0]
+		result.grid[row][1] = r[0]*g[0][1] + r[1]*g[1][1] + r[2]*g[2][1] + r[3]*g[3][1]
+		result.grid[row][2] = r[0]*g[0][2] + r[1]*g[1][2] + r[2]*g[2][2] + r[3]*g[3][2]
+		result.grid[row][3] = r[0]*g[0][3] + r[1]*g[1][3] + r[2]*g[2][3] + r[3]*g[3][3]
+	}
+	return result
+}
+
+// subFactors4x4 calculates the 2x2 determinants of the top two rows and the
+// bottom two rows of a 4x4 matrix. Both the determinant and the inverse are
+// built out of these, which saves creating any submatrices.
+func subFactors4x4(g [][]float64) (s, c [6]float64) {
+	s[0] = g[0][0]*g[1][1] - g[1][0]*g[0][1]
+	s[1] = g[0][0]*g[1][2] - g[1][0]*g[0][2]
+	s[2] = g[0][0]*g[1][3] - g[1][0]*g[0][3]
+	s[3] = g[0][1]*g[1][2] - g[1][1]*g[0][2]
+	s[4] = g[0][1]*g[1][3] - g[1][1]*g[0][3]
+	s[5] = g[0][2]*g[1][3] - g[1][2]*g[0][3]
+
+	c[5] = g[2][2]*g[3][3] - g[3][2]*g[2][3]
+	c[4] = g[2][1]*g[3][3] - g[3][1]*g[2][3]
+	c[3] = g[2][1]*g[3][2] - g[3][1]*g[2][2]
+	c[2] = g[2][0]*g[3][3] - g[3][0]*g[2][3]
+	c[1] = g[2][0]*g[3][2] - g[3][0]*g[2][2]
+	c[0] = g[2][0]*g[3][1] - g[3][0]*g[2][1]
+	return s, c
+}
+
+func determinant4x4(m Matrix) float64 {
+	s, c := subFactors4x4(m.grid)
+	return s[0]*c[5] - s[1]*c[4] + s[2]*c[3] + s[3]*c[2] - s[4]*c[1] + s[5]*c[0]
+}
+
+func inverse4x4(m Matrix) (Matrix, error) {
+	g := m.grid
+	s, c := subFactors4x4(g)
+	det := s[0]*c[5] - s[1]*c[4] + s[2]*c[3] + s[3]*c[2] - s[4]*c[1] + s[5]*c[0]
+	if det == 0 {
+		return Matrix{}, ErrNotInvertible
+	}
+
+	inv := 1 / det
+	result := NewMatrix(4, 4)
+	r := result.grid
+
+	r[0][0] = (g[1][1]*c[5] - g[1][2]*c[4] + g[1][3]*c[3]) * inv
+	r[0][1] = (-g[0][1]*c[5] + g[0][2]*c[4] - g[0][3]*c[3]) * inv
+	r[0][2] = (g[3][1]*s[5] - g[3][2]*s[4] + g[3][3]*s[3]) * inv
+	r[0][3] = (-g[2][1]*s[5] + g[2][2]*s[4] - g[2][3]*s[3]) * inv
+
+	r[1][0] = (-g[1][0]*c[5] + g[1][2]*c[2] - g[1][3]*c[1]) * inv
+	r[1][1] = (g[0][0]*c[5] - g[0][2]*c[2] + g[0][3]*c[1]) * inv
+	r[1][2] = (-g[3][0]*s[5] + g[3][2]*s[2] - g[3][3]*s[1]) * inv
+	r[1][3] = (g[2][0]*s[5] - g[2][2]*s[2] + g[2][3]*s[1]) * inv
+
+	r[2][0] = (g[1][0]*c[4] - g[1][1]*c[2] + g[1][3]*c[0]) * inv
+	r[2][1] = (-g[0][0]*c[4] + g[0][1]*c[2] - g[0][3]*c[0]) * inv
+	r[2][2] = (g[3][0]*s[4] - g[3][1]*s[2] + g[3][3]*s[0]) * inv
+	r[2][3] = (-g[2][0]*s[4] + g[2][1]*s[2] - g[2][3]*s[0]) * inv
+
+	r[3][0] = (-g[1][0]*c[3] + g[1][1]*c[1] - g[1][2]*c[0]) * inv
+	r[3][1] = (g[0][0]*c[3] - g[0][1]*c[1] + g[0][2]*c[0]) * inv
+	r[3][2] = (-g[3][0]*s[3] + g[3][1]*s[1] - g[3][2]*s[0]) * inv
+	r[3][3] = (g[2][0]*s[3] - g[2][1]*s[1] + g[2][2]*s[0]) * inv
+
+	return result, nil
+}
@@ -1,9 +1,13 @@
 package matrix
 
-import "testing"
+import (
+	"testing"
+
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
 
 func TestCreateMatrixAndGetValues(t *testing.T) {
-	m := NewMatrix(
+	m := NewMatrix(4, 4,
 		1, 2, 3, 4,
 		5.5, 6.5, 7.5, 8.5,
 		9, 10, 11, 12,
@@ -12,37 +16,37 @@ func TestCreateMatrixAndGetValues(t *testing.T) {
 
 	errorMessage := "Matrix should have correct values at given row and col. Got %v; Want %v"
 
-	if m.Get(0, 0) != 1 {
-		t.Errorf(errorMessage, m.Get(0, 0), 1)
+	if got, _ := m.Get(0, 0); got != 1 {
+		t.Errorf(errorMessage, got, 1)
 	}
 
-	if m.Get(0, 3) != 4 {
-		t.Errorf(errorMessage, m.Get(0, 3), 4)
+	if got, _ := m.Get(0, 3); got != 4 {
+		t.Errorf(errorMessage, got, 4)
 	}
 
-	if m.Get(1, 0) != 5.5 {
-		t.Errorf(errorMessage, m.Get(1, 0), 5.5)
+	if got, _ := m.Get(1, 0); got != 5.5 {
+		t.Errorf(errorMessage, got, 5.5)
 	}
 
-	if m.Get(1, 2) != 7.5 {
-		t.Errorf(errorMessage, m.Get(1, 2), 7.5)
+	if got, _ := m.Get(1, 2); got != 7.5 {
+		t.Errorf(errorMessage, got, 7.5)
 	}
 
-	if m.Get(2, 2) != 11 {
-		t.Errorf(errorMessage, m.Get(2, 2), 11)
+	if got, _ := m.Get(2, 2); got != 11 {
+		t.Errorf(errorMessage, got, 11)
 	}
 
-	if m.Get(3, 0) != 13.5 {
-		t.Errorf(errorMessage, m.Get(3, 0), 13.5)
+	if got, _ := m.Get(3, 0); got != 13.5 {
+		t.Errorf(errorMessage, got, 13.5)
 	}
 
-	if m.Get(3, 2) != 15.5 {
-		t.Errorf(errorMessage, m.Get(3, 2), 15.5)
+	if got, _ := m.Get(3, 2); got != 15.5 {
+		t.Errorf(errorMessage, got, 15.5)
 	}
 }
 
 func TestMatrixSetsValueZeroIfFewerThan16ArgsAreGiven(t *testing.T) {
-	m := NewMatrix(1, 2, 3, 4)
+	m := NewMatrix(4, 4, 1, 2, 3, 4)
 
 outerLoop:
 	for y, row := range m.grid {
@@ -58,10 +62,567 @@ outerLoop:
 }
 
 func TestSetSingleValueInMatrix(t *testing.T) {
-	m := NewMatrix(1, 2, 3, 4)
+	m := NewMatrix(4, 4, 1, 2, 3, 4)
 	m.Set(0, 2, 5)
 
 	if m.grid[0][2] != 5 {
 		t.Errorf("Expected first row, third item to be updated by set method. Got %v, want %v", m.grid[0][2], 5)
 	}
 }
+
+func TestCreate2x2Matrix(t *testing.T) {
+	m := NewMatrix(2, 2,
+		-3, 5,
+		1, -2,
+	)
+
+	if m.Rows() != 2 || m.Cols() != 2 {
+		t.Errorf("Expected matrix to be 2x2. Got %vx%v", m.Rows(), m.Cols())
+	}
+
+	if got, _ := m.Get(1, 0); got != 1 {
+		t.Errorf("Matrix should have correct value at given row and col. Got %v; Want %v", got, 1)
+	}
+}
+
+func TestCreate3x3Matrix(t *testing.T) {
+	m := NewMatrix(3, 3,
+		-3, 5, 0,
+		1, -2, -7,
+		0, 1, 1,
+	)
+
+	if m.Rows() != 3 || m.Cols() != 3 {
+		t.Errorf("Expected matrix to be 3x3. Got %vx%v", m.Rows(), m.Cols())
+	}
+
+	if got, _ := m.Get(2, 2); got != 1 {
+		t.Errorf("Matrix should have correct value at given row and col. Got %v; Want %v", got, 1)
+	}
+}
+
+func TestGetOutOfRangeReturnsError(t *testing.T) {
+	m := NewMatrix(2, 2, 1, 2, 3, 4)
+
+	if _, err := m.Get(2, 0); err != ErrOutOfRange {
+		t.Errorf("Expected ErrOutOfRange for row outside of matrix. Got %v", err)
+	}
+
+	if _, err := m.Get(0, -1); err != ErrOutOfRange {
+		t.Errorf("Expected ErrOutOfRange for negative col. Got %v", err)
+	}
+}
+
+func TestSetOutOfRangeReturnsError(t *testing.T) {
+	m := NewMatrix(3, 3)
+
+	if err := m.Set(3, 3, 5); err != ErrOutOfRange {
+		t.Errorf("Expected ErrOutOfRange when setting outside of matrix. Got %v", err)
+	}
+
+	if err := m.Set(2, 2, 5); err != nil {
+		t.Errorf("Expected no error when setting inside of matrix. Got %v", err)
+	}
+}
+
+func TestMatricesOfDifferentSizesAreNotEquivalent(t *testing.T) {
+	a := NewMatrix(2, 2)
+	b := NewMatrix(3, 3)
+
+	if a.IsEquivalentTo(b) {
+		t.Errorf("Expected matrices with different dimensions to not be equivalent")
+	}
+}
+
+func TestMultiplyNonSquareMatrices(t *testing.T) {
+	a := NewMatrix(2, 3,
+		1, 2, 3,
+		4, 5, 6,
+	)
+	b := NewMatrix(3, 2,
+		7, 8,
+		9, 10,
+		11, 12,
+	)
+	got := a.Multiply(b)
+	want := NewMatrix(2, 2,
+		58, 64,
+		139, 154,
+	)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected product of 2x3 and 3x2 matrices.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestMultiplyMismatchedMatricesPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected multiplying mismatched matrices to panic")
+		}
+	}()
+	NewMatrix(2, 3).Multiply(NewMatrix(2, 3))
+}
+
+func TestMatricesAreEquivalent(t *testing.T) {
+	a := NewMatrix(4, 4,
+		1, 2, 3, 4,
+		5, 6, 7, 8,
+		9, 8, 7, 6,
+		5, 4, 3, 2,
+	)
+	b := NewMatrix(4, 4,
+		1, 2, 3, 4,
+		5, 6, 7, 8,
+		9, 8, 7, 6,
+		5, 4, 3, 2.000001,
+	)
+
+	if a.IsEquivalentTo(b) == false {
+		t.Errorf("Expected matrices with the same values to be equivalent.\nGot  %v;\nWant %v;", a, b)
+	}
+}
+
+func TestMatricesAreNotEquivalent(t *testing.T) {
+	a := NewMatrix(4, 4,
+		1, 2, 3, 4,
+		5, 6, 7, 8,
+		9, 8, 7, 6,
+		5, 4, 3, 2,
+	)
+	b := NewMatrix(4, 4,
+		2, 3, 4, 5,
+		6, 7, 8, 9,
+		8, 7, 6, 5,
+		4, 3, 2, 1,
+	)
+
+	if a.IsEquivalentTo(b) {
+		t.Errorf("Expected matrices with different values to not be equivalent")
+	}
+}
+
+func TestMultiplyTwoMatrices(t *testing.T) {
+	a := NewMatrix(4, 4,
+		1, 2, 3, 4,
+		5, 6, 7, 8,
+		9, 8, 7, 6,
+		5, 4, 3, 2,
+	)
+	b := NewMatrix(4, 4,
+		-2, 1, 2, 3,
+		3, 2, 1, -1,
+		4, 3, 6, 5,
+		1, 2, 7, 8,
+	)
+	got := a.Multiply(b)
+	want := NewMatrix(4, 4,
+		20, 22, 50, 48,
+		44, 54, 114, 108,
+		40, 58, 110, 102,
+		16, 26, 46, 42,
+	)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected product of two matrices.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestMultiplyMatrixByTuple(t *testing.T) {
+	m := NewMatrix(4, 4,
+		1, 2, 3, 4,
+		2, 4, 4, 2,
+		8, 6, 4, 1,
+		0, 0, 0, 1,
+	)
+	tup := tuples.CreateTuple(1, 2, 3, 1)
+	got := m.MultiplyTuple(tup)
+	want := tuples.CreateTuple(18, 24, 33, 1)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected product of matrix and tuple.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestMultiplyMatrixByIdentity(t *testing.T) {
+	m := NewMatrix(4, 4,
+		0, 1, 2, 4,
+		1, 2, 4, 8,
+		2, 4, 8, 16,
+		4, 8, 16, 32,
+	)
+	got := m.Multiply(Identity())
+
+	if got.IsEquivalentTo(m) == false {
+		t.Errorf("Expected multiplying by identity to return the original matrix.\nGot  %v;\nWant %v;", got, m)
+	}
+}
+
+func TestMultiplyIdentityByTuple(t *testing.T) {
+	tup := tuples.CreateTuple(1, 2, 3, 4)
+	got := Identity().MultiplyTuple(tup)
+
+	if got.IsEquivalentTo(tup) == false {
+		t.Errorf("Expected multiplying identity by a tuple to return the tuple.\nGot  %v;\nWant %v;", got, tup)
+	}
+}
+
+func TestTransposeMatrix(t *testing.T) {
+	m := NewMatrix(4, 4,
+		0, 9, 3, 0,
+		9, 8, 0, 8,
+		1, 8, 5, 3,
+		0, 0, 5, 8,
+	)
+	got := m.Transpose()
+	want := NewMatrix(4, 4,
+		0, 9, 1, 0,
+		9, 8, 8, 0,
+		3, 0, 5, 5,
+		0, 8, 3, 8,
+	)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected transposed matrix.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestTransposeIdentityMatrix(t *testing.T) {
+	got := Identity().Transpose()
+
+	if got.IsEquivalentTo(Identity()) == false {
+		t.Errorf("Expected transposed identity matrix to be the identity matrix. Got %v", got)
+	}
+}
+
+func TestDeterminantOf2x2Matrix(t *testing.T) {
+	m := NewMatrix(2, 2,
+		1, 5,
+		-3, 2,
+	)
+	got := m.Determinant()
+	want := 17.0
+
+	if got != want {
+		t.Errorf("Expected determinant of 2x2 matrix. Got %v; Want %v", got, want)
+	}
+}
+
+func TestSubmatrixOf3x3Matrix(t *testing.T) {
+	m := NewMatrix(3, 3,
+		1, 5, 0,
+		-3, 2, 7,
+		0, 6, -3,
+	)
+	got, _ := m.Submatrix(0, 2)
+	want := NewMatrix(2, 2,
+		-3, 2,
+		0, 6,
+	)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected 2x2 submatrix.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestSubmatrixOf4x4Matrix(t *testing.T) {
+	m := NewMatrix(4, 4,
+		-6, 1, 1, 6,
+		-8, 5, 8, 6,
+		-1, 0, 8, 2,
+		-7, 1, -1, 1,
+	)
+	got, _ := m.Submatrix(2, 1)
+	want := NewMatrix(3, 3,
+		-6, 1, 6,
+		-8, 8, 6,
+		-7, -1, 1,
+	)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected 3x3 submatrix.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestMinorOf3x3Matrix(t *testing.T) {
+	m := NewMatrix(3, 3,
+		3, 5, 0,
+		2, -1, -7,
+		6, -1, 5,
+	)
+	got, _ := m.Minor(1, 0)
+	want := 25.0
+
+	if got != want {
+		t.Errorf("Expected minor of 3x3 matrix. Got %v; Want %v", got, want)
+	}
+}
+
+func TestCofactorOf3x3Matrix(t *testing.T) {
+	m := NewMatrix(3, 3,
+		3, 5, 0,
+		2, -1, -7,
+		6, -1, 5,
+	)
+
+	if got, _ := m.Cofactor(0, 0); got != -12 {
+		t.Errorf("Expected cofactor at 0, 0. Got %v; Want %v", got, -12)
+	}
+
+	if got, _ := m.Cofactor(1, 0); got != -25 {
+		t.Errorf("Expected cofactor at 1, 0 to be negated. Got %v; Want %v", got, -25)
+	}
+}
+
+func TestDeterminantOf3x3Matrix(t *testing.T) {
+	m := NewMatrix(3, 3,
+		1, 2, 6,
+		-5, 8, -4,
+		2, 6, 4,
+	)
+	got := m.Determinant()
+	want := -196.0
+
+	if got != want {
+		t.Errorf("Expected determinant of 3x3 matrix. Got %v; Want %v", got, want)
+	}
+}
+
+func TestDeterminantOf4x4Matrix(t *testing.T) {
+	m := NewMatrix(4, 4,
+		-2, -8, 3, 5,
+		-3, 1, 7, 3,
+		1, 2, -9, 6,
+		-6, 7, 7, -9,
+	)
+	got := m.Determinant()
+	want := -4071.0
+
+	if got != want {
+		t.Errorf("Expected determinant of 4x4 matrix. Got %v; Want %v", got, want)
+	}
+}
+
+func TestDeterminantOf4x4MatchesCofactorExpansion(t *testing.T) {
+	m := NewMatrix(4, 4,
+		-2, -8, 3, 5,
+		-3, 1, 7, 3,
+		1, 2, -9, 6,
+		-6, 7, 7, -9,
+	)
+
+	var want float64
+	for col := 0; col < 4; col++ {
+		value, _ := m.Get(0, col)
+		cofactor, _ := m.Cofactor(0, col)
+		want += value * cofactor
+	}
+
+	if got := m.Determinant(); !tuples.Equals(got, want) {
+		t.Errorf("Expected 4x4 determinant to match cofactor expansion. Got %v; Want %v", got, want)
+	}
+}
+
+func TestInverseOf3x3Matrix(t *testing.T) {
+	m := NewMatrix(3, 3,
+		2, 0, 0,
+		0, 4, 0,
+		0, 0, 8,
+	)
+	got, err := m.Inverse()
+	want := NewMatrix(3, 3,
+		0.5, 0, 0,
+		0, 0.25, 0,
+		0, 0, 0.125,
+	)
+
+	if err != nil {
+		t.Fatalf("Expected matrix to be invertible. Got error %v", err)
+	}
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected inverse of 3x3 matrix.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestMatrixIsInvertible(t *testing.T) {
+	m := NewMatrix(4, 4,
+		6, 4, 4, 4,
+		5, 5, 7, 6,
+		4, -9, 3, -7,
+		9, 1, 7, -6,
+	)
+
+	if m.IsInvertible() == false {
+		t.Errorf("Expected matrix with non-zero determinant to be invertible")
+	}
+}
+
+func TestMatrixIsNotInvertible(t *testing.T) {
+	m := NewMatrix(4, 4,
+		-4, 2, -2, -3,
+		9, 6, 2, 6,
+		0, -5, 1, -5,
+		0, 0, 0, 0,
+	)
+
+	if m.IsInvertible() {
+		t.Errorf("Expected matrix with zero determinant to not be invertible")
+	}
+
+	if _, err := m.Inverse(); err != ErrNotInvertible {
+		t.Errorf("Expected ErrNotInvertible when inverting. Got %v", err)
+	}
+}
+
+func TestInverseOfMatrix(t *testing.T) {
+	m := NewMatrix(4, 4,
+		-5, 2, 6, -8,
+		1, -5, 1, 8,
+		7, 7, -6, -7,
+		1, -3, 7, 4,
+	)
+	got, err := m.Inverse()
+	want := NewMatrix(4, 4,
+		0.21805, 0.45113, 0.24060, -0.04511,
+		-0.80827, -1.45677, -0.44361, 0.52068,
+		-0.07895, -0.22368, -0.05263, 0.19737,
+		-0.52256, -0.81391, -0.30075, 0.30639,
+	)
+
+	if err != nil {
+		t.Fatalf("Expected matrix to be invertible. Got error %v", err)
+	}
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected inverse of matrix.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestMultiplyProductByInverse(t *testing.T) {
+	a := NewMatrix(4, 4,
+		3, -9, 7, 3,
+		3, -8, 2, -9,
+		-4, 4, 4, 1,
+		-6, 5, -1, 1,
+	)
+	b := NewMatrix(4, 4,
+		8, 2, 2, 2,
+		3, -1, 7, 0,
+		7, 0, 5, 4,
+		6, -2, 0, 5,
+	)
+	inverse, _ := b.Inverse()
+	got := a.Multiply(b).Multiply(inverse)
+
+	if got.IsEquivalentTo(a) == false {
+		t.Errorf("Expected multiplying product by inverse to give the original matrix.\nGot  %v;\nWant %v;", got, a)
+	}
+}
+
+func TestMultiplyMatrixByPoint(t *testing.T) {
+	got := Translation(5, -3, 2).MultiplyPoint(tuples.NewPoint(-3, 4, 5))
+	want := tuples.NewPoint(2, 1, 7)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected point to be transformed.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestMultiplyMatrixByVector(t *testing.T) {
+	got := Translation(5, -3, 2).Multiply(Scaling(2, 3, 4)).MultiplyVector(tuples.NewVector(-4, 6, 8))
+	want := tuples.NewVector(-8, 18, 32)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected vector to be scaled but not translated.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestMatrixIsSquare(t *testing.T) {
+	if NewMatrix(3, 3).IsSquare() == false {
+		t.Errorf("Expected 3x3 matrix to be square")
+	}
+
+	if NewMatrix(2, 3).IsSquare() {
+		t.Errorf("Expected 2x3 matrix to not be square")
+	}
+
+	if NewMatrix(0, 0).IsSquare() {
+		t.Errorf("Expected empty matrix to not be square")
+	}
+}
+
+func TestNonSquareMatrixIsNotInvertible(t *testing.T) {
+	for _, m := range []Matrix{
+		NewMatrix(2, 3, 1, 2, 3, 4, 5, 6),
+		NewMatrix(4, 3, 1, 0, 0, 0, 1, 0, 0, 0, 1),
+		NewMatrix(0, 0),
+	} {
+		if m.IsInvertible() {
+			t.Errorf("Expected %dx%d matrix to not be invertible", m.Rows(), m.Cols())
+		}
+
+		if _, err := m.Inverse(); err != ErrNotSquare {
+			t.Errorf("Expected ErrNotSquare when inverting %dx%d matrix. Got %v", m.Rows(), m.Cols(), err)
+		}
+	}
+}
+
+func TestDeterminantOfNonSquareMatrixPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected determinant of non-square matrix to panic")
+		}
+	}()
+	NewMatrix(2, 3, 1, 2, 3, 4, 5, 6).Determinant()
+}
+
+func TestSubmatrixOutOfRange(t *testing.T) {
+	m := NewMatrix(3, 3,
+		1, 2, 6,
+		-5, 8, -4,
+		2, 6, 4,
+	)
+
+	for _, pos := range [][2]int{{7, -2}, {-1, 0}, {0, 3}, {3, 0}} {
+		if _, err := m.Submatrix(pos[0], pos[1]); err != ErrOutOfRange {
+			t.Errorf("Expected ErrOutOfRange for submatrix at %v. Got %v", pos, err)
+		}
+	}
+
+	if _, err := (Matrix{}).Submatrix(0, 0); err != ErrOutOfRange {
+		t.Errorf("Expected ErrOutOfRange for submatrix of empty matrix. Got %v", err)
+	}
+}
+
+func TestMinorAndCofactorOutOfRange(t *testing.T) {
+	m := NewMatrix(3, 3,
+		1, 2, 6,
+		-5, 8, -4,
+		2, 6, 4,
+	)
+
+	if _, err := m.Minor(9, 9); err != ErrOutOfRange {
+		t.Errorf("Expected ErrOutOfRange for minor at 9, 9. Got %v", err)
+	}
+
+	if got, err := m.Cofactor(9, 9); err != ErrOutOfRange {
+		t.Errorf("Expected ErrOutOfRange for cofactor at 9, 9. Got %v, %v", got, err)
+	}
+
+	if _, err := NewMatrix(1, 1, 5).Cofactor(0, 0); err != ErrOutOfRange {
+		t.Errorf("Expected ErrOutOfRange for cofactor of 1x1 matrix. Got %v", err)
+	}
+}
+
+func TestMinorOfNonSquareMatrix(t *testing.T) {
+	m := NewMatrix(2, 3, 1, 2, 3, 4, 5, 6)
+
+	if _, err := m.Minor(0, 0); err != ErrNotSquare {
+		t.Errorf("Expected ErrNotSquare for minor of 2x3 matrix. Got %v", err)
+	}
+
+	if _, err := (Matrix{}).Cofactor(0, 0); err != ErrNotSquare {
+		t.Errorf("Expected ErrNotSquare for cofactor of empty matrix. Got %v", err)
+	}
+}
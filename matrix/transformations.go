@@ -0,0 +1,175 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+// Translation creates a transformation matrix that moves a point by the
+// given x, y and z amounts. Vectors are left untouched since their w
+// component is zero.
+func Translation(x, y, z float64) Matrix {
+	return NewMatrix(4, 4,
+		1, 0, 0, x,
+		0, 1, 0, y,
+		0, 0, 1, z,
+		0, 0, 0, 1,
+	)
+}
+
+// Scaling creates a transformation matrix that scales a point or vector
+// by the given x, y and z amounts. Negative values reflect across that axis.
+func Scaling(x, y, z float64) Matrix {
+	return NewMatrix(4, 4,
+		x, 0, 0, 0,
+		0, y, 0, 0,
+		0, 0, z, 0,
+		0, 0, 0, 1,
+	)
+}
+
+// RotationX creates a transformation matrix that rotates around the x axis
+// by the given number of radians.
+func RotationX(r float64) Matrix {
+	cos, sin := math.Cos(r), math.Sin(r)
+	return NewMatrix(4, 4,
+		1, 0, 0, 0,
+		0, cos, -sin, 0,
+		0, sin, cos, 0,
+		0, 0, 0, 1,
+	)
+}
+
+// RotationY creates a transformation matrix that rotates around the y axis
+// by the given number of radians.
+func RotationY(r float64) Matrix {
+	cos, sin := math.Cos(r), math.Sin(r)
+	return NewMatrix(4, 4,
+		cos, 0, sin, 0,
+		0, 1, 0, 0,
+		-sin, 0, cos, 0,
+		0, 0, 0, 1,
+	)
+}
+
+// RotationZ creates a transformation matrix that rotates around the z axis
+// by the given number of radians.
+func RotationZ(r float64) Matrix {
+	cos, sin := math.Cos(r), math.Sin(r)
+	return NewMatrix(4, 4,
+		cos, -sin, 0, 0,
+		sin, cos, 0, 0,
+		0, 0, 1, 0,
+		0, 0, 0, 1,
+	)
+}
+
+// Shearing creates a transformation matrix that moves each component of a
+// tuple in proportion to the other two. e.g xy moves x in proportion to y.
+func Shearing(xy, xz, yx, yz, zx, zy float64) Matrix {
+	return NewMatrix(4, 4,
+		1, xy, xz, 0,
+		yx, 1, yz, 0,
+		zx, zy, 1, 0,
+		0, 0, 0, 1,
+	)
+}
+
+// Translate applies a translation after the calling transformation and
+// returns the combined matrix. Meant to be chained from Identity() so that
+// transformations read in the order they are applied.
+// e.g
+// Identity().RotateX(math.Pi / 2).Scale(5, 5, 5).Translate(10, 5, 7)
+func (m Matrix) Translate(x, y, z float64) Matrix {
+	return Translation(x, y, z).Multiply(m)
+}
+
+// Scale applies a scaling after the calling transformation and returns the
+// combined matrix.
+func (m Matrix) Scale(x, y, z float64) Matrix {
+	return Scaling(x, y, z).Multiply(m)
+}
+
+// RotateX applies a rotation around the x axis after the calling
+// transformation and returns the combined matrix.
+func (m Matrix) RotateX(r float64) Matrix {
+	return RotationX(r).Multiply(m)
+}
+
+// RotateY applies a rotation around the y axis after the calling
+// transformation and returns the combined matrix.
+func (m Matrix) RotateY(r float64) Matrix {
+	return RotationY(r).Multiply(m)
+}
+
+// RotateZ applies a rotation around the z axis after the calling
+// transformation and returns the combined matrix.
+func (m Matrix) RotateZ(r float64) Matrix {
+	return RotationZ(r).Multiply(m)
+}
+
+// Shear applies a shearing after the calling transformation and returns the
+// combined matrix.
+func (m Matrix) Shear(xy, xz, yx, yz, zx, zy float64) Matrix {
+	return Shearing(xy, xz, yx, yz, zx, zy).Multiply(m)
+}
+
+// ErrViewNotPoint is returned from ViewTransform when the from or to
+// argument is not a point.
+var ErrViewNotPoint = errors.New("view transform from and to must be points")
+
+// ErrViewNotVector is returned from ViewTransform when the up argument is
+// not a vector.
+var ErrViewNotVector = errors.New("view transform up must be a vector")
+
+// ErrViewSamePoint is returned from ViewTransform when from and to are the
+// same point, leaving no direction to look in.
+var ErrViewSamePoint = errors.New("view transform from and to must be different points")
+
+// ErrViewUpParallel is returned from ViewTransform when the up vector is
+// zero or parallel to the direction being looked in.
+var ErrViewUpParallel = errors.New("view transform up must not be parallel to the view direction")
+
+// ViewTransform creates a transformation matrix that orients the world
+// relative to an eye positioned at from, looking towards to. The up vector
+// only needs to point roughly upwards, it is corrected using the cross
+// product of the other directions.
+func ViewTransform(from, to, up tuples.Tuple) (Matrix, error) {
+	eye, fromOk := from.ToPoint()
+	target, toOk := to.ToPoint()
+	if !fromOk || !toOk {
+		return Matrix{}, ErrViewNotPoint
+	}
+
+	upVector, ok := up.ToVector()
+	if !ok {
+		return Matrix{}, ErrViewNotVector
+	}
+
+	direction := target.Subtract(eye)
+	if direction.Magnitude() < tuples.EPSILON {
+		return Matrix{}, ErrViewSamePoint
+	}
+
+	if upVector.Magnitude() < tuples.EPSILON {
+		return Matrix{}, ErrViewUpParallel
+	}
+
+	forward := direction.Normalize()
+	left := forward.Cross(upVector.Normalize())
+	if left.Magnitude() < tuples.EPSILON {
+		return Matrix{}, ErrViewUpParallel
+	}
+
+	trueUp := left.Cross(forward)
+
+	orientation := NewMatrix(4, 4,
+		left.X, left.Y, left.Z, 0,
+		trueUp.X, trueUp.Y, trueUp.Z, 0,
+		-forward.X, -forward.Y, -forward.Z, 0,
+		0, 0, 0, 1,
+	)
+	return orientation.Multiply(Translation(-eye.X, -eye.Y, -eye.Z)), nil
+}
@@ -0,0 +1,319 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+func TestTranslatePoint(t *testing.T) {
+	transform := Translation(5, -3, 2)
+	p := tuples.CreatePoint(-3, 4, 5)
+	got := transform.MultiplyTuple(p)
+	want := tuples.CreatePoint(2, 1, 7)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected point to be translated.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestTranslatePointByInverse(t *testing.T) {
+	inverse, _ := Translation(5, -3, 2).Inverse()
+	p := tuples.CreatePoint(-3, 4, 5)
+	got := inverse.MultiplyTuple(p)
+	want := tuples.CreatePoint(-8, 7, 3)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected point to be translated in reverse.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestTranslationDoesNotAffectVectors(t *testing.T) {
+	transform := Translation(5, -3, 2)
+	v := tuples.CreateVector(-3, 4, 5)
+	got := transform.MultiplyTuple(v)
+
+	if got.IsEquivalentTo(v) == false {
+		t.Errorf("Expected vector to be unchanged by translation.\nGot  %v;\nWant %v;", got, v)
+	}
+}
+
+func TestScalePoint(t *testing.T) {
+	transform := Scaling(2, 3, 4)
+	p := tuples.CreatePoint(-4, 6, 8)
+	got := transform.MultiplyTuple(p)
+	want := tuples.CreatePoint(-8, 18, 32)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected point to be scaled.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestScaleVector(t *testing.T) {
+	transform := Scaling(2, 3, 4)
+	v := tuples.CreateVector(-4, 6, 8)
+	got := transform.MultiplyTuple(v)
+	want := tuples.CreateVector(-8, 18, 32)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected vector to be scaled.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestScaleVectorByInverse(t *testing.T) {
+	inverse, _ := Scaling(2, 3, 4).Inverse()
+	v := tuples.CreateVector(-4, 6, 8)
+	got := inverse.MultiplyTuple(v)
+	want := tuples.CreateVector(-2, 2, 2)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected vector to be shrunk by inverse scaling.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestReflectionIsScalingByNegativeValue(t *testing.T) {
+	transform := Scaling(-1, 1, 1)
+	p := tuples.CreatePoint(2, 3, 4)
+	got := transform.MultiplyTuple(p)
+	want := tuples.CreatePoint(-2, 3, 4)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected point to be reflected across the x axis.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestRotatePointAroundX(t *testing.T) {
+	p := tuples.CreatePoint(0, 1, 0)
+	halfQuarter := RotationX(math.Pi / 4)
+	fullQuarter := RotationX(math.Pi / 2)
+
+	got := halfQuarter.MultiplyTuple(p)
+	want := tuples.CreatePoint(0, math.Sqrt2/2, math.Sqrt2/2)
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected point to be rotated an eighth around x.\nGot  %v;\nWant %v;", got, want)
+	}
+
+	got = fullQuarter.MultiplyTuple(p)
+	want = tuples.CreatePoint(0, 0, 1)
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected point to be rotated a quarter around x.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestInverseRotationAroundX(t *testing.T) {
+	p := tuples.CreatePoint(0, 1, 0)
+	inverse, _ := RotationX(math.Pi / 4).Inverse()
+	got := inverse.MultiplyTuple(p)
+	want := tuples.CreatePoint(0, math.Sqrt2/2, -math.Sqrt2/2)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected inverse rotation to rotate the opposite way.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestRotatePointAroundY(t *testing.T) {
+	p := tuples.CreatePoint(0, 0, 1)
+	halfQuarter := RotationY(math.Pi / 4)
+	fullQuarter := RotationY(math.Pi / 2)
+
+	got := halfQuarter.MultiplyTuple(p)
+	want := tuples.CreatePoint(math.Sqrt2/2, 0, math.Sqrt2/2)
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected point to be rotated an eighth around y.\nGot  %v;\nWant %v;", got, want)
+	}
+
+	got = fullQuarter.MultiplyTuple(p)
+	want = tuples.CreatePoint(1, 0, 0)
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected point to be rotated a quarter around y.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestRotatePointAroundZ(t *testing.T) {
+	p := tuples.CreatePoint(0, 1, 0)
+	halfQuarter := RotationZ(math.Pi / 4)
+	fullQuarter := RotationZ(math.Pi / 2)
+
+	got := halfQuarter.MultiplyTuple(p)
+	want := tuples.CreatePoint(-math.Sqrt2/2, math.Sqrt2/2, 0)
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected point to be rotated an eighth around z.\nGot  %v;\nWant %v;", got, want)
+	}
+
+	got = fullQuarter.MultiplyTuple(p)
+	want = tuples.CreatePoint(-1, 0, 0)
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected point to be rotated a quarter around z.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestShearingMovesEachComponent(t *testing.T) {
+	p := tuples.CreatePoint(2, 3, 4)
+	cases := []struct {
+		transform Matrix
+		want      tuples.Tuple
+	}{
+		{Shearing(1, 0, 0, 0, 0, 0), tuples.CreatePoint(5, 3, 4)},
+		{Shearing(0, 1, 0, 0, 0, 0), tuples.CreatePoint(6, 3, 4)},
+		{Shearing(0, 0, 1, 0, 0, 0), tuples.CreatePoint(2, 5, 4)},
+		{Shearing(0, 0, 0, 1, 0, 0), tuples.CreatePoint(2, 7, 4)},
+		{Shearing(0, 0, 0, 0, 1, 0), tuples.CreatePoint(2, 3, 6)},
+		{Shearing(0, 0, 0, 0, 0, 1), tuples.CreatePoint(2, 3, 7)},
+	}
+
+	for _, c := range cases {
+		got := c.transform.MultiplyTuple(p)
+		if got.IsEquivalentTo(c.want) == false {
+			t.Errorf("Expected point to be sheared.\nGot  %v;\nWant %v;", got, c.want)
+		}
+	}
+}
+
+func TestChainedTransformationsApplyInReverseOrder(t *testing.T) {
+	p := tuples.CreatePoint(1, 0, 1)
+	rotate := RotationX(math.Pi / 2)
+	scale := Scaling(5, 5, 5)
+	translate := Translation(10, 5, 7)
+	got := translate.Multiply(scale).Multiply(rotate).MultiplyTuple(p)
+	want := tuples.CreatePoint(15, 0, 7)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected chained transformations to be applied in reverse order.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestFluentTransformationsApplyInCallOrder(t *testing.T) {
+	p := tuples.CreatePoint(1, 0, 1)
+	transform := Identity().
+		RotateX(math.Pi/2).
+		Scale(5, 5, 5).
+		Translate(10, 5, 7)
+	got := transform.MultiplyTuple(p)
+	want := tuples.CreatePoint(15, 0, 7)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected fluent transformations to be applied in call order.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestFluentTransformationsMatchManualMultiplication(t *testing.T) {
+	got := Identity().
+		Shear(1, 0, 0, 0, 0, 1).
+		RotateY(math.Pi/3).
+		RotateZ(math.Pi/6).
+		Translate(1, 2, 3)
+	want := Translation(1, 2, 3).
+		Multiply(RotationZ(math.Pi / 6)).
+		Multiply(RotationY(math.Pi / 3)).
+		Multiply(Shearing(1, 0, 0, 0, 0, 1))
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected fluent transformations to match manual multiplication.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestFluentTransformationsDoNotModifyOriginal(t *testing.T) {
+	base := Identity()
+	base.Translate(1, 2, 3)
+
+	if base.IsEquivalentTo(Identity()) == false {
+		t.Errorf("Expected chaining to return a new matrix. Got %v", base)
+	}
+}
+
+func TestViewTransformForDefaultOrientation(t *testing.T) {
+	from := tuples.CreatePoint(0, 0, 0)
+	to := tuples.CreatePoint(0, 0, -1)
+	up := tuples.CreateVector(0, 1, 0)
+	got, err := ViewTransform(from, to, up)
+
+	if err != nil {
+		t.Fatalf("Expected no error for valid view transform. Got %v", err)
+	}
+
+	if got.IsEquivalentTo(Identity()) == false {
+		t.Errorf("Expected default orientation to be the identity matrix.\nGot  %v;\nWant %v;", got, Identity())
+	}
+}
+
+func TestViewTransformLookingInPositiveZ(t *testing.T) {
+	from := tuples.CreatePoint(0, 0, 0)
+	to := tuples.CreatePoint(0, 0, 1)
+	up := tuples.CreateVector(0, 1, 0)
+	got, _ := ViewTransform(from, to, up)
+	want := Scaling(-1, 1, -1)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected looking in positive z to reflect the world.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestViewTransformMovesTheWorld(t *testing.T) {
+	from := tuples.CreatePoint(0, 0, 8)
+	to := tuples.CreatePoint(0, 0, 0)
+	up := tuples.CreateVector(0, 1, 0)
+	got, _ := ViewTransform(from, to, up)
+	want := Translation(0, 0, -8)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected view transform to move the world.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestArbitraryViewTransform(t *testing.T) {
+	from := tuples.CreatePoint(1, 3, 2)
+	to := tuples.CreatePoint(4, -2, 8)
+	up := tuples.CreateVector(1, 1, 0)
+	got, _ := ViewTransform(from, to, up)
+	want := NewMatrix(4, 4,
+		-0.50709, 0.50709, 0.67612, -2.36643,
+		0.76772, 0.60609, 0.12122, -2.82843,
+		-0.35857, 0.59761, -0.71714, 0,
+		0, 0, 0, 1,
+	)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected arbitrary view transform.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestViewTransformRejectsInvalidTuples(t *testing.T) {
+	point := tuples.CreatePoint(0, 0, 0)
+	vector := tuples.CreateVector(0, 1, 0)
+
+	if _, err := ViewTransform(vector, point, vector); err != ErrViewNotPoint {
+		t.Errorf("Expected ErrViewNotPoint when from is a vector. Got %v", err)
+	}
+
+	if _, err := ViewTransform(point, vector, vector); err != ErrViewNotPoint {
+		t.Errorf("Expected ErrViewNotPoint when to is a vector. Got %v", err)
+	}
+
+	if _, err := ViewTransform(point, tuples.CreatePoint(0, 0, -1), point); err != ErrViewNotVector {
+		t.Errorf("Expected ErrViewNotVector when up is a point. Got %v", err)
+	}
+}
+
+func TestViewTransformRejectsDegenerateViews(t *testing.T) {
+	from := tuples.CreatePoint(1, 2, 3)
+	up := tuples.CreateVector(0, 1, 0)
+
+	if _, err := ViewTransform(from, from, up); err != ErrViewSamePoint {
+		t.Errorf("Expected ErrViewSamePoint when from and to are equal. Got %v", err)
+	}
+
+	above := tuples.CreatePoint(1, 5, 3)
+	if _, err := ViewTransform(from, above, up); err != ErrViewUpParallel {
+		t.Errorf("Expected ErrViewUpParallel when looking along up. Got %v", err)
+	}
+
+	below := tuples.CreatePoint(1, -5, 3)
+	if _, err := ViewTransform(from, below, up); err != ErrViewUpParallel {
+		t.Errorf("Expected ErrViewUpParallel when looking against up. Got %v", err)
+	}
+
+	if _, err := ViewTransform(from, tuples.CreatePoint(0, 0, 0), tuples.CreateVector(0, 0, 0)); err != ErrViewUpParallel {
+		t.Errorf("Expected ErrViewUpParallel when up is a zero vector. Got %v", err)
+	}
+}
@@ -0,0 +1,211 @@
+package obj
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/riavalon/ray_tracer/shapes"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+// Result struct holds everything read from a Wavefront OBJ file. Faces
+// read before any group statement go in DefaultGroup, faces after a group
+// statement go into Groups under the group's name. GroupNames keeps the
+// order the groups first appeared in. Ignored counts the lines that were
+// not recognised or could not be parsed.
+type Result struct {
+	Vertices     []tuples.Point
+	Normals      []tuples.Vector
+	DefaultGroup []shapes.Shape
+	Groups       map[string][]shapes.Shape
+	GroupNames   []string
+	Ignored      int
+}
+
+// Triangles returns every triangle read from the file, starting with the
+// default group followed by each named group in order.
+func (res *Result) Triangles() []shapes.Shape {
+	triangles := append([]shapes.Shape{}, res.DefaultGroup...)
+	for _, name := range res.GroupNames {
+		triangles = append(triangles, res.Groups[name]...)
+	}
+	return triangles
+}
+
+// ToGroup builds a group out of every triangle read from the file. Triangles
+// in the default group are added directly, and each named group becomes a
+// child group of its own.
+func (res *Result) ToGroup() *shapes.Group {
+	g := shapes.NewGroup()
+	for _, triangle := range res.DefaultGroup {
+		g.AddChild(triangle)
+	}
+
+	for _, name := range res.GroupNames {
+		child := shapes.NewGroup()
+		for _, triangle := range res.Groups[name] {
+			child.AddChild(triangle)
+		}
+		g.AddChild(child)
+	}
+	return g
+}
+
+// Parse reads a Wavefront OBJ file from the reader. Vertices ("v"), vertex
+// normals ("vn"), faces ("f") and named groups ("g") are supported. Faces
+// with more than three vertices are split into a fan of triangles, and
+// faces that give a normal for each vertex become smooth triangles. Any
+// other lines are counted in Result.Ignored instead of causing a failure.
+// Only errors from reading the file itself are returned.
+func Parse(r io.Reader) (*Result, error) {
+	res := &Result{Groups: map[string][]shapes.Shape{}}
+	group := ""
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		ok := false
+		switch fields[0] {
+		case "v":
+			var p tuples.Point
+			if p, ok = parsePoint(fields[1:]); ok {
+				res.Vertices = append(res.Vertices, p)
+			}
+		case "vn":
+			var v tuples.Vector
+			if v, ok = parseVector(fields[1:]); ok {
+				res.Normals = append(res.Normals, v)
+			}
+		case "f":
+			var triangles []shapes.Shape
+			if triangles, ok = res.parseFace(fields[1:]); ok {
+				res.addTriangles(group, triangles)
+			}
+		case "g":
+			if ok = len(fields) > 1; ok {
+				group = strings.Join(fields[1:], " ")
+				if _, exists := res.Groups[group]; !exists {
+					res.Groups[group] = []shapes.Shape{}
+					res.GroupNames = append(res.GroupNames, group)
+				}
+			}
+		}
+
+		if !ok {
+			res.Ignored++
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ParseGroup reads a Wavefront OBJ file from the reader and returns its
+// triangles as a group, built the same way as Result.ToGroup. The parsed
+// result is returned as well so the ignored line count can be reported.
+func ParseGroup(r io.Reader) (*shapes.Group, *Result, error) {
+	res, err := Parse(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return res.ToGroup(), res, nil
+}
+
+func (res *Result) addTriangles(group string, triangles []shapes.Shape) {
+	if group == "" {
+		res.DefaultGroup = append(res.DefaultGroup, triangles...)
+		return
+	}
+	res.Groups[group] = append(res.Groups[group], triangles...)
+}
+
+// parseFace turns the vertex references of a face into triangles. Each
+// reference is "v", "v/vt" or "v/vt/vn". Texture coordinates are skipped.
+func (res *Result) parseFace(refs []string) ([]shapes.Shape, bool) {
+	if len(refs) < 3 {
+		return nil, false
+	}
+
+	points := make([]tuples.Point, len(refs))
+	normals := make([]tuples.Vector, len(refs))
+	smooth := true
+
+	for i, ref := range refs {
+		parts := strings.Split(ref, "/")
+
+		vertex, ok := lookup(parts[0], len(res.Vertices))
+		if !ok {
+			return nil, false
+		}
+		points[i] = res.Vertices[vertex]
+
+		if len(parts) < 3 || parts[2] == "" {
+			smooth = false
+			continue
+		}
+
+		normal, ok := lookup(parts[2], len(res.Normals))
+		if !ok {
+			return nil, false
+		}
+		normals[i] = res.Normals[normal]
+	}
+
+	// Split the polygon into a fan of triangles sharing the first vertex
+	triangles := make([]shapes.Shape, 0, len(points)-2)
+	for i := 1; i < len(points)-1; i++ {
+		if smooth {
+			triangles = append(triangles, shapes.NewSmoothTriangle(
+				points[0], points[i], points[i+1],
+				normals[0], normals[i], normals[i+1],
+			))
+			continue
+		}
+		triangles = append(triangles, shapes.NewTriangle(points[0], points[i], points[i+1]))
+	}
+	return triangles, true
+}
+
+// lookup converts a 1 based OBJ index into a 0 based slice index, checking
+// that it refers to something that has already been read.
+func lookup(ref string, count int) (int, bool) {
+	index, err := strconv.Atoi(ref)
+	if err != nil || index < 1 || index > count {
+		return 0, false
+	}
+	return index - 1, true
+}
+
+func parseFloats(fields []string) (float64, float64, float64, bool) {
+	if len(fields) < 3 {
+		return 0, 0, 0, false
+	}
+
+	var values [3]float64
+	for i := range values {
+		value, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return 0, 0, 0, false
+		}
+		values[i] = value
+	}
+	return values[0], values[1], values[2], true
+}
+
+func parsePoint(fields []string) (tuples.Point, bool) {
+	x, y, z, ok := parseFloats(fields)
+	return tuples.NewPoint(x, y, z), ok
+}
+
+func parseVector(fields []string) (tuples.Vector, bool) {
+	x, y, z, ok := parseFloats(fields)
+	return tuples.NewVector(x, y, z), ok
+}
@@ -0,0 +1,290 @@
+package obj
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/riavalon/ray_tracer/shapes"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+func TestIgnoreUnrecognisedLines(t *testing.T) {
+	gibberish := `There was a young lady named Bright
+who traveled much faster than light.
+She set out one day
+in a relative way,
+and came back the previous night.`
+	res, err := Parse(strings.NewReader(gibberish))
+
+	if err != nil {
+		t.Fatalf("Expected unrecognised lines to not cause an error. Got %v", err)
+	}
+
+	if res.Ignored != 5 {
+		t.Errorf("Expected every line to be ignored. Got %v; Want %v", res.Ignored, 5)
+	}
+}
+
+func TestParseVertexRecords(t *testing.T) {
+	file := `v -1 1 0
+v -1.0000 0.5000 0.0000
+v 1 0 0
+v 1 1 0`
+	res, _ := Parse(strings.NewReader(file))
+	want := []tuples.Point{
+		tuples.NewPoint(-1, 1, 0),
+		tuples.NewPoint(-1, 0.5, 0),
+		tuples.NewPoint(1, 0, 0),
+		tuples.NewPoint(1, 1, 0),
+	}
+
+	if len(res.Vertices) != len(want) {
+		t.Fatalf("Expected %v vertices. Got %v", len(want), len(res.Vertices))
+	}
+
+	for i, v := range res.Vertices {
+		if v.IsEquivalentTo(want[i]) == false {
+			t.Errorf("Expected vertex %v. Got %v; Want %v", i+1, v, want[i])
+		}
+	}
+}
+
+func TestParseTriangleFaces(t *testing.T) {
+	file := `v -1 1 0
+v -1 0 0
+v 1 0 0
+v 1 1 0
+
+f 1 2 3
+f 1 3 4`
+	res, _ := Parse(strings.NewReader(file))
+
+	if len(res.DefaultGroup) != 2 {
+		t.Fatalf("Expected two triangles in default group. Got %v", len(res.DefaultGroup))
+	}
+
+	t1 := res.DefaultGroup[0].(*shapes.Triangle)
+	t2 := res.DefaultGroup[1].(*shapes.Triangle)
+	v := res.Vertices
+
+	if !t1.P1.IsEquivalentTo(v[0]) || !t1.P2.IsEquivalentTo(v[1]) || !t1.P3.IsEquivalentTo(v[2]) {
+		t.Errorf("Expected first triangle to use vertices 1, 2, 3. Got %v", t1)
+	}
+
+	if !t2.P1.IsEquivalentTo(v[0]) || !t2.P2.IsEquivalentTo(v[2]) || !t2.P3.IsEquivalentTo(v[3]) {
+		t.Errorf("Expected second triangle to use vertices 1, 3, 4. Got %v", t2)
+	}
+}
+
+func TestTriangulatePolygons(t *testing.T) {
+	file := `v -1 1 0
+v -1 0 0
+v 1 0 0
+v 1 1 0
+v 0 2 0
+
+f 1 2 3 4 5`
+	res, _ := Parse(strings.NewReader(file))
+
+	if len(res.DefaultGroup) != 3 {
+		t.Fatalf("Expected polygon to be split into three triangles. Got %v", len(res.DefaultGroup))
+	}
+
+	v := res.Vertices
+	for i, s := range res.DefaultGroup {
+		tri := s.(*shapes.Triangle)
+		if !tri.P1.IsEquivalentTo(v[0]) || !tri.P2.IsEquivalentTo(v[i+1]) || !tri.P3.IsEquivalentTo(v[i+2]) {
+			t.Errorf("Expected triangle %v to fan out from the first vertex. Got %v", i, tri)
+		}
+	}
+}
+
+func TestTrianglesInGroups(t *testing.T) {
+	file := `v -1 1 0
+v -1 0 0
+v 1 0 0
+v 1 1 0
+g FirstGroup
+f 1 2 3
+g SecondGroup
+f 1 3 4`
+	res, _ := Parse(strings.NewReader(file))
+
+	if len(res.DefaultGroup) != 0 {
+		t.Errorf("Expected no triangles in default group. Got %v", len(res.DefaultGroup))
+	}
+
+	if len(res.GroupNames) != 2 || res.GroupNames[0] != "FirstGroup" || res.GroupNames[1] != "SecondGroup" {
+		t.Errorf("Expected group names in order. Got %v", res.GroupNames)
+	}
+
+	if len(res.Groups["FirstGroup"]) != 1 || len(res.Groups["SecondGroup"]) != 1 {
+		t.Errorf("Expected one triangle in each group. Got %v", res.Groups)
+	}
+
+	if len(res.Triangles()) != 2 {
+		t.Errorf("Expected every triangle to be returned. Got %v", len(res.Triangles()))
+	}
+}
+
+func TestParseVertexNormalRecords(t *testing.T) {
+	file := `vn 0 0 1
+vn 0.707 0 -0.707
+vn 1 2 3`
+	res, _ := Parse(strings.NewReader(file))
+	want := []tuples.Vector{
+		tuples.NewVector(0, 0, 1),
+		tuples.NewVector(0.707, 0, -0.707),
+		tuples.NewVector(1, 2, 3),
+	}
+
+	if len(res.Normals) != len(want) {
+		t.Fatalf("Expected %v normals. Got %v", len(want), len(res.Normals))
+	}
+
+	for i, n := range res.Normals {
+		if n.IsEquivalentTo(want[i]) == false {
+			t.Errorf("Expected normal %v. Got %v; Want %v", i+1, n, want[i])
+		}
+	}
+}
+
+func TestFacesWithNormals(t *testing.T) {
+	file := `v 0 1 0
+v -1 0 0
+v 1 0 0
+
+vn -1 0 0
+vn 1 0 0
+vn 0 1 0
+
+f 1//3 2//1 3//2
+f 1/0/3 2/102/1 3/14/2`
+	res, _ := Parse(strings.NewReader(file))
+
+	if len(res.DefaultGroup) != 2 {
+		t.Fatalf("Expected two smooth triangles. Got %v", len(res.DefaultGroup))
+	}
+
+	for _, s := range res.DefaultGroup {
+		tri, ok := s.(*shapes.SmoothTriangle)
+		if !ok {
+			t.Fatalf("Expected faces with normals to be smooth triangles. Got %T", s)
+		}
+
+		if !tri.N1.IsEquivalentTo(res.Normals[2]) || !tri.N2.IsEquivalentTo(res.Normals[0]) || !tri.N3.IsEquivalentTo(res.Normals[1]) {
+			t.Errorf("Expected smooth triangle to use normals 3, 1, 2. Got %v", tri)
+		}
+	}
+}
+
+func TestMalformedLinesAreIgnored(t *testing.T) {
+	file := `v 1 2
+v 1 two 3
+v 0 1 0
+v -1 0 0
+v 1 0 0
+vn 1 0
+f 1 2
+f 1 2 9
+f 1 2 3
+g`
+	res, err := Parse(strings.NewReader(file))
+
+	if err != nil {
+		t.Fatalf("Expected malformed lines to not cause an error. Got %v", err)
+	}
+
+	if res.Ignored != 6 {
+		t.Errorf("Expected malformed lines to be counted. Got %v; Want %v", res.Ignored, 6)
+	}
+
+	if len(res.Vertices) != 3 || len(res.DefaultGroup) != 1 {
+		t.Errorf("Expected valid lines to still be parsed. Got %v vertices and %v triangles", len(res.Vertices), len(res.DefaultGroup))
+	}
+}
+
+type failingReader struct{}
+
+func (failingReader) Read(p []byte) (int, error) {
+	return 0, errors.New("read failed")
+}
+
+func TestReadErrorIsReturned(t *testing.T) {
+	if _, err := Parse(failingReader{}); err == nil {
+		t.Errorf("Expected error from reader to be returned")
+	}
+}
+
+func TestConvertResultToGroup(t *testing.T) {
+	file := `v -1 1 0
+v -1 0 0
+v 1 0 0
+v 1 1 0
+f 1 2 4
+g FirstGroup
+f 1 2 3
+g SecondGroup
+f 1 3 4`
+	res, _ := Parse(strings.NewReader(file))
+	g := res.ToGroup()
+	children := g.Children()
+
+	if len(children) != 3 {
+		t.Fatalf("Expected default triangle and two named groups. Got %v children", len(children))
+	}
+
+	if children[0] != res.DefaultGroup[0] {
+		t.Errorf("Expected default group triangles to be added directly. Got %v", children[0])
+	}
+
+	for i, name := range res.GroupNames {
+		child, ok := children[i+1].(*shapes.Group)
+		if !ok {
+			t.Fatalf("Expected named group to become a child group. Got %T", children[i+1])
+		}
+
+		if len(child.Children()) != 1 || child.Children()[0] != res.Groups[name][0] {
+			t.Errorf("Expected %v to contain its triangles. Got %v", name, child.Children())
+		}
+
+		if child.Parent() != g {
+			t.Errorf("Expected %v to belong to the returned group", name)
+		}
+	}
+}
+
+func TestParseFileIntoGroup(t *testing.T) {
+	file := `v -1 1 0
+v -1 0 0
+v 1 0 0
+v 1 1 0
+not a real line
+f 1 2 3 4
+g FirstGroup
+f 1 2 3`
+	g, res, err := ParseGroup(strings.NewReader(file))
+	if err != nil {
+		t.Fatalf("Expected file to parse without error. Got %v", err)
+	}
+
+	if res.Ignored != 1 {
+		t.Errorf("Expected ignored lines to be reported. Got %v; Want %v", res.Ignored, 1)
+	}
+
+	children := g.Children()
+	if len(children) != 3 {
+		t.Fatalf("Expected two default triangles and a named group. Got %v children", len(children))
+	}
+
+	for i, triangle := range res.DefaultGroup {
+		if children[i] != triangle {
+			t.Errorf("Expected triangle %v to be a direct child of the group. Got %v", i, children[i])
+		}
+	}
+
+	if child, ok := children[2].(*shapes.Group); !ok || len(child.Children()) != 1 {
+		t.Errorf("Expected FirstGroup to become a child group with one triangle. Got %v", children[2])
+	}
+}
@@ -0,0 +1,34 @@
+package rays
+
+import (
+	"github.com/riavalon/ray_tracer/matrix"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+// Ray struct represents a line starting at an origin point and travelling
+// in the direction of a vector.
+type Ray struct {
+	Origin    tuples.Point
+	Direction tuples.Vector
+}
+
+// Position finds the point along the ray at distance t from the origin.
+// Negative values of t give points behind the origin.
+func (r Ray) Position(t float64) tuples.Point {
+	return r.Origin.Add(r.Direction.MultiplyByScalar(t))
+}
+
+// Transform applies the given transformation matrix to both the origin and
+// direction of the ray, returning the new ray. The calling ray is not
+// modified.
+func (r Ray) Transform(m matrix.Matrix) Ray {
+	return NewRay(m.MultiplyPoint(r.Origin), m.MultiplyVector(r.Direction))
+}
+
+// NewRay creates a ray with the given origin and direction.
+func NewRay(origin tuples.Point, direction tuples.Vector) Ray {
+	return Ray{
+		Origin:    origin,
+		Direction: direction,
+	}
+}
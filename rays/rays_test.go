@@ -0,0 +1,75 @@
+package rays
+
+import (
+	"testing"
+
+	"github.com/riavalon/ray_tracer/matrix"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+func TestNewRay(t *testing.T) {
+	origin := tuples.NewPoint(1, 2, 3)
+	direction := tuples.NewVector(4, 5, 6)
+	r := NewRay(origin, direction)
+
+	if r.Origin.IsEquivalentTo(origin) == false {
+		t.Errorf("Ray should have init origin. Got %v; Want %v", r.Origin, origin)
+	}
+
+	if r.Direction.IsEquivalentTo(direction) == false {
+		t.Errorf("Ray should have init direction. Got %v; Want %v", r.Direction, direction)
+	}
+}
+
+func TestPositionAlongRay(t *testing.T) {
+	r := NewRay(tuples.NewPoint(2, 3, 4), tuples.NewVector(1, 0, 0))
+	cases := []struct {
+		t    float64
+		want tuples.Point
+	}{
+		{0, tuples.NewPoint(2, 3, 4)},
+		{1, tuples.NewPoint(3, 3, 4)},
+		{-1, tuples.NewPoint(1, 3, 4)},
+		{2.5, tuples.NewPoint(4.5, 3, 4)},
+	}
+
+	for _, c := range cases {
+		if got := r.Position(c.t); got.IsEquivalentTo(c.want) == false {
+			t.Errorf("Expected position at t %v.\nGot  %v;\nWant %v;", c.t, got, c.want)
+		}
+	}
+}
+
+func TestTranslateRay(t *testing.T) {
+	r := NewRay(tuples.NewPoint(1, 2, 3), tuples.NewVector(0, 1, 0))
+	got := r.Transform(matrix.Translation(3, 4, 5))
+	wantOrigin := tuples.NewPoint(4, 6, 8)
+	wantDirection := tuples.NewVector(0, 1, 0)
+
+	if got.Origin.IsEquivalentTo(wantOrigin) == false {
+		t.Errorf("Expected origin to be translated. Got %v; Want %v", got.Origin, wantOrigin)
+	}
+
+	if got.Direction.IsEquivalentTo(wantDirection) == false {
+		t.Errorf("Expected direction to be unchanged. Got %v; Want %v", got.Direction, wantDirection)
+	}
+}
+
+func TestScaleRay(t *testing.T) {
+	r := NewRay(tuples.NewPoint(1, 2, 3), tuples.NewVector(0, 1, 0))
+	got := r.Transform(matrix.Scaling(2, 3, 4))
+	wantOrigin := tuples.NewPoint(2, 6, 12)
+	wantDirection := tuples.NewVector(0, 3, 0)
+
+	if got.Origin.IsEquivalentTo(wantOrigin) == false {
+		t.Errorf("Expected origin to be scaled. Got %v; Want %v", got.Origin, wantOrigin)
+	}
+
+	if got.Direction.IsEquivalentTo(wantDirection) == false {
+		t.Errorf("Expected direction to be scaled. Got %v; Want %v", got.Direction, wantDirection)
+	}
+
+	if r.Origin.IsEquivalentTo(tuples.NewPoint(1, 2, 3)) == false {
+		t.Errorf("Expected original ray to be unchanged. Got %v", r.Origin)
+	}
+}
@@ -0,0 +1,93 @@
+package shapes
+
+import (
+	"math"
+
+	"github.com/riavalon/ray_tracer/rays"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+// DoubleCone struct represents two cones joined at their tips, which sit
+// on the origin in object space. The radius at any point along the y axis
+// is the absolute value of y. Minimum, Maximum and Closed work the same way
+// as they do for a Cylinder.
+type DoubleCone struct {
+	base
+	Minimum float64
+	Maximum float64
+	Closed  bool
+}
+
+// LocalIntersect finds where the object space ray crosses the walls of
+// the cone and, if the cone is closed, its end caps.
+func (dc *DoubleCone) LocalIntersect(r rays.Ray) Intersections {
+	xs := Intersections{}
+
+	a := r.Direction.X*r.Direction.X - r.Direction.Y*r.Direction.Y + r.Direction.Z*r.Direction.Z
+	b := 2*r.Origin.X*r.Direction.X - 2*r.Origin.Y*r.Direction.Y + 2*r.Origin.Z*r.Direction.Z
+	c := r.Origin.X*r.Origin.X - r.Origin.Y*r.Origin.Y + r.Origin.Z*r.Origin.Z
+
+	var ts []float64
+	switch {
+	case math.Abs(a) < tuples.EPSILON && math.Abs(b) < tuples.EPSILON:
+		// The ray misses the walls entirely
+	case math.Abs(a) < tuples.EPSILON:
+		// The ray is parallel to one of the halves, so only hits the other
+		ts = []float64{-c / (2 * b)}
+	default:
+		discriminant := b*b - 4*a*c
+		if discriminant < 0 {
+			return xs
+		}
+
+		root := math.Sqrt(discriminant)
+		t0 := (-b - root) / (2 * a)
+		t1 := (-b + root) / (2 * a)
+		if t0 > t1 {
+			t0, t1 = t1, t0
+		}
+		ts = []float64{t0, t1}
+	}
+
+	for _, t := range ts {
+		y := r.Origin.Y + t*r.Direction.Y
+		if dc.Minimum < y && y < dc.Maximum {
+			xs = append(xs, NewIntersection(t, dc))
+		}
+	}
+
+	if dc.Closed {
+		xs = intersectCaps(dc, r, dc.Minimum, dc.Maximum, math.Abs, xs)
+	}
+	return NewIntersections(xs...)
+}
+
+// LocalNormalAt finds the normal of the cone at the given object space
+// point. Points within EPSILON of an end are treated as being on the cap.
+func (dc *DoubleCone) LocalNormalAt(p tuples.Point, hit Intersection) tuples.Vector {
+	distance := p.X*p.X + p.Z*p.Z
+
+	if distance < p.Y*p.Y && p.Y >= dc.Maximum-tuples.EPSILON {
+		return tuples.NewVector(0, 1, 0)
+	}
+
+	if distance < p.Y*p.Y && p.Y <= dc.Minimum+tuples.EPSILON {
+		return tuples.NewVector(0, -1, 0)
+	}
+
+	y := math.Sqrt(distance)
+	if p.Y > 0 {
+		y = -y
+	}
+	return tuples.NewVector(p.X, y, p.Z)
+}
+
+// NewDoubleCone creates an infinitely long, open double cone with the
+// identity matrix as its transform.
+func NewDoubleCone() *DoubleCone {
+	return &DoubleCone{
+		base:    newBase(),
+		Minimum: math.Inf(-1),
+		Maximum: math.Inf(1),
+	}
+}
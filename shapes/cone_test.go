@@ -0,0 +1,137 @@
+package shapes
+
+import (
+	"math"
+	"testing"
+
+	"github.com/riavalon/ray_tracer/rays"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+func TestRayStrikesDoubleCone(t *testing.T) {
+	dc := NewDoubleCone()
+	cases := []struct {
+		origin    tuples.Point
+		direction tuples.Vector
+		t0, t1    float64
+	}{
+		{tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1), 5, 5},
+		{tuples.NewPoint(0, 0, -5), tuples.NewVector(1, 1, 1), 8.66025, 8.66025},
+		{tuples.NewPoint(1, 1, -5), tuples.NewVector(-0.5, -1, 1), 4.55006, 49.44994},
+	}
+
+	for _, tc := range cases {
+		r := rays.NewRay(tc.origin, tc.direction.Normalize())
+		got := dc.LocalIntersect(r)
+		if len(got) != 2 || !tuples.Equals(got[0].T, tc.t0) || !tuples.Equals(got[1].T, tc.t1) {
+			t.Errorf("Expected ray from %v to hit cone at %v and %v. Got %v", tc.origin, tc.t0, tc.t1, got)
+		}
+	}
+}
+
+func TestRayParallelToHalfOfDoubleCone(t *testing.T) {
+	dc := NewDoubleCone()
+	r := rays.NewRay(tuples.NewPoint(0, 0, -1), tuples.NewVector(0, 1, 1).Normalize())
+	got := dc.LocalIntersect(r)
+
+	if len(got) != 1 || !tuples.Equals(got[0].T, 0.35355) {
+		t.Errorf("Expected ray parallel to one half to hit the cone once at %v. Got %v", 0.35355, got)
+	}
+}
+
+func TestDefaultDoubleConeIsInfiniteAndOpen(t *testing.T) {
+	dc := NewDoubleCone()
+
+	if !math.IsInf(dc.Minimum, -1) || !math.IsInf(dc.Maximum, 1) {
+		t.Errorf("Expected default cone to be infinite. Got min %v; max %v", dc.Minimum, dc.Maximum)
+	}
+
+	if dc.Closed {
+		t.Errorf("Expected default cone to be open")
+	}
+}
+
+func TestIntersectCapsOfClosedDoubleCone(t *testing.T) {
+	dc := NewDoubleCone()
+	dc.Minimum = -0.5
+	dc.Maximum = 0.5
+	dc.Closed = true
+	cases := []struct {
+		origin    tuples.Point
+		direction tuples.Vector
+		count     int
+	}{
+		{tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 1, 0), 0},
+		{tuples.NewPoint(0, 0, -0.25), tuples.NewVector(0, 1, 1), 2},
+		{tuples.NewPoint(0, 0, -0.25), tuples.NewVector(0, 1, 0), 4},
+	}
+
+	for _, tc := range cases {
+		r := rays.NewRay(tc.origin, tc.direction.Normalize())
+		if got := dc.LocalIntersect(r); len(got) != tc.count {
+			t.Errorf("Expected %v intersections with closed cone from %v. Got %v", tc.count, tc.origin, got)
+		}
+	}
+}
+
+func TestNormalOnDoubleCone(t *testing.T) {
+	dc := NewDoubleCone()
+	cases := []struct {
+		point tuples.Point
+		want  tuples.Vector
+	}{
+		{tuples.NewPoint(0, 0, 0), tuples.NewVector(0, 0, 0)},
+		{tuples.NewPoint(1, 1, 1), tuples.NewVector(1, -math.Sqrt2, 1)},
+		{tuples.NewPoint(-1, -1, 0), tuples.NewVector(-1, 1, 0)},
+	}
+
+	for _, tc := range cases {
+		if got := dc.LocalNormalAt(tc.point, Intersection{}); got.IsEquivalentTo(tc.want) == false {
+			t.Errorf("Expected normal at %v on cone.\nGot  %v;\nWant %v;", tc.point, got, tc.want)
+		}
+	}
+}
+
+func TestNormalOnDoubleConeEndCaps(t *testing.T) {
+	dc := NewDoubleCone()
+	dc.Minimum = -1
+	dc.Maximum = 2
+	dc.Closed = true
+	cases := []struct {
+		point tuples.Point
+		want  tuples.Vector
+	}{
+		{tuples.NewPoint(0.5, -1, 0), tuples.NewVector(0, -1, 0)},
+		{tuples.NewPoint(0, 2, 1.5), tuples.NewVector(0, 1, 0)},
+	}
+
+	for _, tc := range cases {
+		if got := dc.LocalNormalAt(tc.point, Intersection{}); got.IsEquivalentTo(tc.want) == false {
+			t.Errorf("Expected cap normal at %v on cone.\nGot  %v;\nWant %v;", tc.point, got, tc.want)
+		}
+	}
+}
+
+func TestClosedDoubleConeIntersectionsAreSorted(t *testing.T) {
+	dc := NewDoubleCone()
+	dc.Minimum = -0.5
+	dc.Maximum = 0.5
+	dc.Closed = true
+	r := rays.NewRay(tuples.NewPoint(0.3, 1, 0), tuples.NewVector(0, -1, 0))
+	got := dc.LocalIntersect(r)
+	want := []float64{0.5, 0.7, 1.3, 1.5}
+
+	if len(got) != len(want) {
+		t.Fatalf("Expected ray through both caps to hit the cone %v times. Got %v", len(want), got)
+	}
+
+	for i := range want {
+		if !tuples.Equals(got[i].T, want[i]) {
+			t.Errorf("Expected intersection %v at %v. Got %v", i, want[i], got[i].T)
+		}
+	}
+
+	if hit, ok := got.Hit(); !ok || !tuples.Equals(hit.T, 0.5) {
+		t.Errorf("Expected hit to be the top cap at %v. Got %v", 0.5, hit)
+	}
+}
@@ -0,0 +1,95 @@
+package shapes
+
+import (
+	"github.com/riavalon/ray_tracer/rays"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+// CSGOperation decides how the two shapes of a CSG shape are combined.
+type CSGOperation int
+
+const (
+	// CSGUnion keeps the surfaces of both shapes that are not inside the
+	// other shape.
+	CSGUnion CSGOperation = iota
+	// CSGIntersection keeps only the parts of each shape that are inside
+	// the other shape.
+	CSGIntersection
+	// CSGDifference keeps the left shape, with the right shape cut out.
+	CSGDifference
+)
+
+// CSG struct is a shape built by combining two other shapes using
+// constructive solid geometry. Either shape may itself be a group or
+// another CSG shape.
+type CSG struct {
+	base
+	Operation CSGOperation
+	Left      Shape
+	Right     Shape
+}
+
+// LocalIntersect intersects the object space ray with both shapes, keeping
+// only the intersections allowed by the operation.
+func (c *CSG) LocalIntersect(r rays.Ray) Intersections {
+	xs := append(Intersect(c.Left, r), Intersect(c.Right, r)...)
+	return c.FilterIntersections(NewIntersections(xs...))
+}
+
+// LocalNormalAt panics, since a CSG shape has no surface of its own.
+// Normals are always found on the shape that was hit.
+func (c *CSG) LocalNormalAt(p tuples.Point, hit Intersection) tuples.Vector {
+	panic("Cannot find the normal of a CSG shape, use the normal of a child instead")
+}
+
+// FilterIntersections walks through the sorted intersections, keeping
+// track of whether the ray is inside the left and right shapes, and keeps
+// only the intersections allowed by the operation.
+func (c *CSG) FilterIntersections(xs Intersections) Intersections {
+	inLeft, inRight := false, false
+	result := Intersections{}
+
+	for _, x := range xs {
+		leftHit := Includes(c.Left, x.Object)
+
+		if IntersectionAllowed(c.Operation, leftHit, inLeft, inRight) {
+			result = append(result, x)
+		}
+
+		if leftHit {
+			inLeft = !inLeft
+		} else {
+			inRight = !inRight
+		}
+	}
+	return result
+}
+
+// IntersectionAllowed decides if an intersection is kept by the operation.
+// leftHit is true if the left shape was hit, and inLeft and inRight tell
+// whether the hit happened inside the left and right shapes.
+func IntersectionAllowed(op CSGOperation, leftHit, inLeft, inRight bool) bool {
+	switch op {
+	case CSGUnion:
+		return (leftHit && !inRight) || (!leftHit && !inLeft)
+	case CSGIntersection:
+		return (leftHit && inRight) || (!leftHit && inLeft)
+	case CSGDifference:
+		return (leftHit && !inRight) || (!leftHit && inLeft)
+	}
+	return false
+}
+
+// NewCSG creates a CSG shape combining left and right with the given
+// operation, making the new shape the parent of both.
+func NewCSG(op CSGOperation, left, right Shape) *CSG {
+	c := &CSG{
+		base:      newBase(),
+		Operation: op,
+		Left:      left,
+		Right:     right,
+	}
+	left.SetParent(c)
+	right.SetParent(c)
+	return c
+}
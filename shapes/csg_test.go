@@ -0,0 +1,151 @@
+package shapes
+
+import (
+	"testing"
+
+	"github.com/riavalon/ray_tracer/matrix"
+	"github.com/riavalon/ray_tracer/rays"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+func TestNewCSG(t *testing.T) {
+	s1 := NewSphere()
+	s2 := NewCube()
+	c := NewCSG(CSGUnion, s1, s2)
+
+	if c.Operation != CSGUnion || c.Left != s1 || c.Right != s2 {
+		t.Errorf("CSG should have init operation and shapes. Got %v", c)
+	}
+
+	if s1.Parent() != c || s2.Parent() != c {
+		t.Errorf("Expected CSG to be the parent of both shapes")
+	}
+}
+
+func TestIntersectionAllowedRules(t *testing.T) {
+	cases := []struct {
+		op                       CSGOperation
+		leftHit, inLeft, inRight bool
+		want                     bool
+	}{
+		{CSGUnion, true, true, true, false},
+		{CSGUnion, true, true, false, true},
+		{CSGUnion, true, false, true, false},
+		{CSGUnion, true, false, false, true},
+		{CSGUnion, false, true, true, false},
+		{CSGUnion, false, true, false, false},
+		{CSGUnion, false, false, true, true},
+		{CSGUnion, false, false, false, true},
+		{CSGIntersection, true, true, true, true},
+		{CSGIntersection, true, true, false, false},
+		{CSGIntersection, true, false, true, true},
+		{CSGIntersection, true, false, false, false},
+		{CSGIntersection, false, true, true, true},
+		{CSGIntersection, false, true, false, true},
+		{CSGIntersection, false, false, true, false},
+		{CSGIntersection, false, false, false, false},
+		{CSGDifference, true, true, true, false},
+		{CSGDifference, true, true, false, true},
+		{CSGDifference, true, false, true, false},
+		{CSGDifference, true, false, false, true},
+		{CSGDifference, false, true, true, true},
+		{CSGDifference, false, true, false, true},
+		{CSGDifference, false, false, true, false},
+		{CSGDifference, false, false, false, false},
+	}
+
+	for _, tc := range cases {
+		got := IntersectionAllowed(tc.op, tc.leftHit, tc.inLeft, tc.inRight)
+		if got != tc.want {
+			t.Errorf("Expected rule for op %v, leftHit %v, inLeft %v, inRight %v. Got %v; Want %v",
+				tc.op, tc.leftHit, tc.inLeft, tc.inRight, got, tc.want)
+		}
+	}
+}
+
+func TestFilterIntersections(t *testing.T) {
+	cases := []struct {
+		op     CSGOperation
+		x0, x1 int
+	}{
+		{CSGUnion, 0, 3},
+		{CSGIntersection, 1, 2},
+		{CSGDifference, 0, 1},
+	}
+
+	for _, tc := range cases {
+		s1 := NewSphere()
+		s2 := NewCube()
+		c := NewCSG(tc.op, s1, s2)
+		xs := NewIntersections(
+			NewIntersection(1, s1),
+			NewIntersection(2, s2),
+			NewIntersection(3, s1),
+			NewIntersection(4, s2),
+		)
+		got := c.FilterIntersections(xs)
+
+		if len(got) != 2 || got[0] != xs[tc.x0] || got[1] != xs[tc.x1] {
+			t.Errorf("Expected op %v to keep intersections %v and %v. Got %v", tc.op, tc.x0, tc.x1, got)
+		}
+	}
+}
+
+func TestRayMissesCSG(t *testing.T) {
+	c := NewCSG(CSGUnion, NewSphere(), NewCube())
+	r := rays.NewRay(tuples.NewPoint(0, 2, -5), tuples.NewVector(0, 0, 1))
+
+	if got := c.LocalIntersect(r); len(got) != 0 {
+		t.Errorf("Expected ray to miss CSG. Got %v", got)
+	}
+}
+
+func TestRayHitsCSG(t *testing.T) {
+	s1 := NewSphere()
+	s2 := NewSphere()
+	s2.SetTransform(matrix.Translation(0, 0, 0.5))
+	c := NewCSG(CSGUnion, s1, s2)
+	r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1))
+	got := c.LocalIntersect(r)
+
+	if len(got) != 2 {
+		t.Fatalf("Expected two intersections with CSG. Got %v", got)
+	}
+
+	if !tuples.Equals(got[0].T, 4) || got[0].Object != s1 {
+		t.Errorf("Expected first hit on left sphere at t 4. Got %v", got[0])
+	}
+
+	if !tuples.Equals(got[1].T, 6.5) || got[1].Object != s2 {
+		t.Errorf("Expected second hit on right sphere at t 6.5. Got %v", got[1])
+	}
+}
+
+func TestIncludesChildrenOfGroupsAndCSG(t *testing.T) {
+	s1 := NewSphere()
+	s2 := NewCube()
+	g := NewGroup()
+	g.AddChild(s2)
+	c := NewCSG(CSGDifference, s1, g)
+
+	if Includes(c, s2) == false {
+		t.Errorf("Expected CSG to include shape nested in a group")
+	}
+
+	if Includes(g, s1) {
+		t.Errorf("Expected group to not include shape outside of it")
+	}
+
+	if Includes(s1, s1) == false {
+		t.Errorf("Expected shape to include itself")
+	}
+}
+
+func TestCSGNormalPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected finding the normal of a CSG shape to panic")
+		}
+	}()
+	NewCSG(CSGUnion, NewSphere(), NewCube()).LocalNormalAt(tuples.NewPoint(0, 0, 0), Intersection{})
+}
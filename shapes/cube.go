@@ -0,0 +1,77 @@
+package shapes
+
+import (
+	"math"
+
+	"github.com/riavalon/ray_tracer/rays"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+// Cube struct represents an axis aligned box. In object space the cube
+// is centred on the origin and extends from -1 to 1 along every axis.
+type Cube struct {
+	base
+}
+
+// LocalIntersect finds where the object space ray enters and leaves the
+// cube. Each pair of faces is treated as a slab, and the ray only hits
+// the cube if it is inside all three slabs at the same time.
+func (c *Cube) LocalIntersect(r rays.Ray) Intersections {
+	xMin, xMax := checkAxis(r.Origin.X, r.Direction.X, -1, 1)
+	yMin, yMax := checkAxis(r.Origin.Y, r.Direction.Y, -1, 1)
+	zMin, zMax := checkAxis(r.Origin.Z, r.Direction.Z, -1, 1)
+
+	tMin := math.Max(xMin, math.Max(yMin, zMin))
+	tMax := math.Min(xMax, math.Min(yMax, zMax))
+	if tMin > tMax {
+		return Intersections{}
+	}
+
+	return Intersections{
+		NewIntersection(tMin, c),
+		NewIntersection(tMax, c),
+	}
+}
+
+// LocalNormalAt finds the normal of the cube at the given object space
+// point. The component with the largest absolute value tells us which
+// face the point is on.
+func (c *Cube) LocalNormalAt(p tuples.Point, hit Intersection) tuples.Vector {
+	absX, absY, absZ := math.Abs(p.X), math.Abs(p.Y), math.Abs(p.Z)
+	maxComponent := math.Max(absX, math.Max(absY, absZ))
+
+	switch maxComponent {
+	case absX:
+		return tuples.NewVector(p.X, 0, 0)
+	case absY:
+		return tuples.NewVector(0, p.Y, 0)
+	}
+	return tuples.NewVector(0, 0, p.Z)
+}
+
+// NewCube creates a cube with the identity matrix as its transform.
+func NewCube() *Cube {
+	return &Cube{base: newBase()}
+}
+
+// checkAxis finds the distances along one axis where the ray crosses the
+// planes at min and max, returned in increasing order. Rays parallel to
+// the planes give infinite distances so the other axes decide the result.
+func checkAxis(origin, direction, min, max float64) (float64, float64) {
+	tMinNumerator := min - origin
+	tMaxNumerator := max - origin
+
+	var tMin, tMax float64
+	if math.Abs(direction) >= tuples.EPSILON {
+		tMin = tMinNumerator / direction
+		tMax = tMaxNumerator / direction
+	} else {
+		tMin = tMinNumerator * math.Inf(1)
+		tMax = tMaxNumerator * math.Inf(1)
+	}
+
+	if tMin > tMax {
+		return tMax, tMin
+	}
+	return tMin, tMax
+}
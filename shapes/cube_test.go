@@ -0,0 +1,77 @@
+package shapes
+
+import (
+	"testing"
+
+	"github.com/riavalon/ray_tracer/rays"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+func TestRayIntersectsCube(t *testing.T) {
+	c := NewCube()
+	cases := []struct {
+		name      string
+		origin    tuples.Point
+		direction tuples.Vector
+		t1, t2    float64
+	}{
+		{"+x", tuples.NewPoint(5, 0.5, 0), tuples.NewVector(-1, 0, 0), 4, 6},
+		{"-x", tuples.NewPoint(-5, 0.5, 0), tuples.NewVector(1, 0, 0), 4, 6},
+		{"+y", tuples.NewPoint(0.5, 5, 0), tuples.NewVector(0, -1, 0), 4, 6},
+		{"-y", tuples.NewPoint(0.5, -5, 0), tuples.NewVector(0, 1, 0), 4, 6},
+		{"+z", tuples.NewPoint(0.5, 0, 5), tuples.NewVector(0, 0, -1), 4, 6},
+		{"-z", tuples.NewPoint(0.5, 0, -5), tuples.NewVector(0, 0, 1), 4, 6},
+		{"inside", tuples.NewPoint(0, 0.5, 0), tuples.NewVector(0, 0, 1), -1, 1},
+	}
+
+	for _, tc := range cases {
+		got := c.LocalIntersect(rays.NewRay(tc.origin, tc.direction))
+		if len(got) != 2 || got[0].T != tc.t1 || got[1].T != tc.t2 {
+			t.Errorf("Expected ray from %v to hit cube at %v and %v. Got %v", tc.name, tc.t1, tc.t2, got)
+		}
+	}
+}
+
+func TestRayMissesCube(t *testing.T) {
+	c := NewCube()
+	cases := []struct {
+		origin    tuples.Point
+		direction tuples.Vector
+	}{
+		{tuples.NewPoint(-2, 0, 0), tuples.NewVector(0.2673, 0.5345, 0.8018)},
+		{tuples.NewPoint(0, -2, 0), tuples.NewVector(0.8018, 0.2673, 0.5345)},
+		{tuples.NewPoint(0, 0, -2), tuples.NewVector(0.5345, 0.8018, 0.2673)},
+		{tuples.NewPoint(2, 0, 2), tuples.NewVector(0, 0, -1)},
+		{tuples.NewPoint(0, 2, 2), tuples.NewVector(0, -1, 0)},
+		{tuples.NewPoint(2, 2, 0), tuples.NewVector(-1, 0, 0)},
+	}
+
+	for _, tc := range cases {
+		if got := c.LocalIntersect(rays.NewRay(tc.origin, tc.direction)); len(got) != 0 {
+			t.Errorf("Expected ray from %v to miss cube. Got %v", tc.origin, got)
+		}
+	}
+}
+
+func TestNormalOnSurfaceOfCube(t *testing.T) {
+	c := NewCube()
+	cases := []struct {
+		point tuples.Point
+		want  tuples.Vector
+	}{
+		{tuples.NewPoint(1, 0.5, -0.8), tuples.NewVector(1, 0, 0)},
+		{tuples.NewPoint(-1, -0.2, 0.9), tuples.NewVector(-1, 0, 0)},
+		{tuples.NewPoint(-0.4, 1, -0.1), tuples.NewVector(0, 1, 0)},
+		{tuples.NewPoint(0.3, -1, -0.7), tuples.NewVector(0, -1, 0)},
+		{tuples.NewPoint(-0.6, 0.3, 1), tuples.NewVector(0, 0, 1)},
+		{tuples.NewPoint(0.4, 0.4, -1), tuples.NewVector(0, 0, -1)},
+		{tuples.NewPoint(1, 1, 1), tuples.NewVector(1, 0, 0)},
+		{tuples.NewPoint(-1, -1, -1), tuples.NewVector(-1, 0, 0)},
+	}
+
+	for _, tc := range cases {
+		if got := c.LocalNormalAt(tc.point, Intersection{}); got.IsEquivalentTo(tc.want) == false {
+			t.Errorf("Expected normal at %v on cube.\nGot  %v;\nWant %v;", tc.point, got, tc.want)
+		}
+	}
+}
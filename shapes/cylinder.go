@@ -0,0 +1,109 @@
+package shapes
+
+import (
+	"math"
+
+	"github.com/riavalon/ray_tracer/rays"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+// Cylinder struct represents a cylinder with a radius of 1 running along
+// the y axis in object space. Minimum and Maximum truncate the cylinder
+// along y, exclusive of the values themselves. Closed adds caps to the
+// ends of a truncated cylinder.
+type Cylinder struct {
+	base
+	Minimum float64
+	Maximum float64
+	Closed  bool
+}
+
+// LocalIntersect finds where the object space ray crosses the walls of
+// the cylinder and, if the cylinder is closed, its end caps.
+func (c *Cylinder) LocalIntersect(r rays.Ray) Intersections {
+	xs := Intersections{}
+
+	a := r.Direction.X*r.Direction.X + r.Direction.Z*r.Direction.Z
+
+	// Rays parallel to the y axis can only hit the caps
+	if math.Abs(a) >= tuples.EPSILON {
+		b := 2*r.Origin.X*r.Direction.X + 2*r.Origin.Z*r.Direction.Z
+		c2 := r.Origin.X*r.Origin.X + r.Origin.Z*r.Origin.Z - 1
+
+		discriminant := b*b - 4*a*c2
+		if discriminant < 0 {
+			return xs
+		}
+
+		root := math.Sqrt(discriminant)
+		t0 := (-b - root) / (2 * a)
+		t1 := (-b + root) / (2 * a)
+		if t0 > t1 {
+			t0, t1 = t1, t0
+		}
+
+		for _, t := range []float64{t0, t1} {
+			y := r.Origin.Y + t*r.Direction.Y
+			if c.Minimum < y && y < c.Maximum {
+				xs = append(xs, NewIntersection(t, c))
+			}
+		}
+	}
+
+	if c.Closed {
+		xs = intersectCaps(c, r, c.Minimum, c.Maximum, func(y float64) float64 { return 1 }, xs)
+	}
+	return NewIntersections(xs...)
+}
+
+// LocalNormalAt finds the normal of the cylinder at the given object space
+// point. Points within EPSILON of an end are treated as being on the cap.
+func (c *Cylinder) LocalNormalAt(p tuples.Point, hit Intersection) tuples.Vector {
+	distance := p.X*p.X + p.Z*p.Z
+
+	if distance < 1 && p.Y >= c.Maximum-tuples.EPSILON {
+		return tuples.NewVector(0, 1, 0)
+	}
+
+	if distance < 1 && p.Y <= c.Minimum+tuples.EPSILON {
+		return tuples.NewVector(0, -1, 0)
+	}
+
+	return tuples.NewVector(p.X, 0, p.Z)
+}
+
+// NewCylinder creates an infinitely long, open cylinder with the identity
+// matrix as its transform.
+func NewCylinder() *Cylinder {
+	return &Cylinder{
+		base:    newBase(),
+		Minimum: math.Inf(-1),
+		Maximum: math.Inf(1),
+	}
+}
+
+// intersectCaps checks if the ray crosses the caps at min and max, adding
+// any hits to xs. radius gives the radius of the cap at a given y, which
+// lets cylinders and cones share the same logic.
+func intersectCaps(s Shape, r rays.Ray, min, max float64, radius func(y float64) float64, xs Intersections) Intersections {
+	// A ray parallel to the caps can never cross them
+	if math.Abs(r.Direction.Y) < tuples.EPSILON {
+		return xs
+	}
+
+	for _, y := range []float64{min, max} {
+		t := (y - r.Origin.Y) / r.Direction.Y
+		if withinCap(r, t, radius(y)) {
+			xs = append(xs, NewIntersection(t, s))
+		}
+	}
+	return xs
+}
+
+// withinCap checks if the point at t along the ray is within radius of
+// the y axis.
+func withinCap(r rays.Ray, t, radius float64) bool {
+	x := r.Origin.X + t*r.Direction.X
+	z := r.Origin.Z + t*r.Direction.Z
+	return x*x+z*z <= radius*radius
+}
@@ -0,0 +1,171 @@
+package shapes
+
+import (
+	"math"
+	"testing"
+
+	"github.com/riavalon/ray_tracer/rays"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+func TestRayMissesCylinder(t *testing.T) {
+	c := NewCylinder()
+	cases := []struct {
+		origin    tuples.Point
+		direction tuples.Vector
+	}{
+		{tuples.NewPoint(1, 0, 0), tuples.NewVector(0, 1, 0)},
+		{tuples.NewPoint(0, 0, 0), tuples.NewVector(0, 1, 0)},
+		{tuples.NewPoint(0, 0, -5), tuples.NewVector(1, 1, 1)},
+	}
+
+	for _, tc := range cases {
+		r := rays.NewRay(tc.origin, tc.direction.Normalize())
+		if got := c.LocalIntersect(r); len(got) != 0 {
+			t.Errorf("Expected ray from %v to miss cylinder. Got %v", tc.origin, got)
+		}
+	}
+}
+
+func TestRayStrikesCylinder(t *testing.T) {
+	c := NewCylinder()
+	cases := []struct {
+		origin    tuples.Point
+		direction tuples.Vector
+		t0, t1    float64
+	}{
+		{tuples.NewPoint(1, 0, -5), tuples.NewVector(0, 0, 1), 5, 5},
+		{tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1), 4, 6},
+		{tuples.NewPoint(0.5, 0, -5), tuples.NewVector(0.1, 1, 1), 6.80798, 7.08872},
+	}
+
+	for _, tc := range cases {
+		r := rays.NewRay(tc.origin, tc.direction.Normalize())
+		got := c.LocalIntersect(r)
+		if len(got) != 2 || !tuples.Equals(got[0].T, tc.t0) || !tuples.Equals(got[1].T, tc.t1) {
+			t.Errorf("Expected ray from %v to hit cylinder at %v and %v. Got %v", tc.origin, tc.t0, tc.t1, got)
+		}
+	}
+}
+
+func TestNormalOnCylinder(t *testing.T) {
+	c := NewCylinder()
+	cases := []struct {
+		point tuples.Point
+		want  tuples.Vector
+	}{
+		{tuples.NewPoint(1, 0, 0), tuples.NewVector(1, 0, 0)},
+		{tuples.NewPoint(0, 5, -1), tuples.NewVector(0, 0, -1)},
+		{tuples.NewPoint(0, -2, 1), tuples.NewVector(0, 0, 1)},
+		{tuples.NewPoint(-1, 1, 0), tuples.NewVector(-1, 0, 0)},
+	}
+
+	for _, tc := range cases {
+		if got := c.LocalNormalAt(tc.point, Intersection{}); got.IsEquivalentTo(tc.want) == false {
+			t.Errorf("Expected normal at %v on cylinder.\nGot  %v;\nWant %v;", tc.point, got, tc.want)
+		}
+	}
+}
+
+func TestDefaultCylinderIsInfiniteAndOpen(t *testing.T) {
+	c := NewCylinder()
+
+	if !math.IsInf(c.Minimum, -1) || !math.IsInf(c.Maximum, 1) {
+		t.Errorf("Expected default cylinder to be infinite. Got min %v; max %v", c.Minimum, c.Maximum)
+	}
+
+	if c.Closed {
+		t.Errorf("Expected default cylinder to be open")
+	}
+}
+
+func TestIntersectTruncatedCylinder(t *testing.T) {
+	c := NewCylinder()
+	c.Minimum = 1
+	c.Maximum = 2
+	cases := []struct {
+		origin    tuples.Point
+		direction tuples.Vector
+		count     int
+	}{
+		{tuples.NewPoint(0, 1.5, 0), tuples.NewVector(0.1, 1, 0), 0},
+		{tuples.NewPoint(0, 3, -5), tuples.NewVector(0, 0, 1), 0},
+		{tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1), 0},
+		{tuples.NewPoint(0, 2, -5), tuples.NewVector(0, 0, 1), 0},
+		{tuples.NewPoint(0, 1, -5), tuples.NewVector(0, 0, 1), 0},
+		{tuples.NewPoint(0, 1.5, -2), tuples.NewVector(0, 0, 1), 2},
+	}
+
+	for _, tc := range cases {
+		r := rays.NewRay(tc.origin, tc.direction.Normalize())
+		if got := c.LocalIntersect(r); len(got) != tc.count {
+			t.Errorf("Expected %v intersections with truncated cylinder from %v. Got %v", tc.count, tc.origin, got)
+		}
+	}
+}
+
+func TestIntersectCapsOfClosedCylinder(t *testing.T) {
+	c := NewCylinder()
+	c.Minimum = 1
+	c.Maximum = 2
+	c.Closed = true
+	cases := []struct {
+		origin    tuples.Point
+		direction tuples.Vector
+		count     int
+	}{
+		{tuples.NewPoint(0, 3, 0), tuples.NewVector(0, -1, 0), 2},
+		{tuples.NewPoint(0, 3, -2), tuples.NewVector(0, -1, 2), 2},
+		{tuples.NewPoint(0, 4, -2), tuples.NewVector(0, -1, 1), 2},
+		{tuples.NewPoint(0, 0, -2), tuples.NewVector(0, 1, 2), 2},
+		{tuples.NewPoint(0, -1, -2), tuples.NewVector(0, 1, 1), 2},
+	}
+
+	for _, tc := range cases {
+		r := rays.NewRay(tc.origin, tc.direction.Normalize())
+		if got := c.LocalIntersect(r); len(got) != tc.count {
+			t.Errorf("Expected %v intersections with closed cylinder from %v. Got %v", tc.count, tc.origin, got)
+		}
+	}
+}
+
+func TestNormalOnCylinderEndCaps(t *testing.T) {
+	c := NewCylinder()
+	c.Minimum = 1
+	c.Maximum = 2
+	c.Closed = true
+	cases := []struct {
+		point tuples.Point
+		want  tuples.Vector
+	}{
+		{tuples.NewPoint(0, 1, 0), tuples.NewVector(0, -1, 0)},
+		{tuples.NewPoint(0.5, 1, 0), tuples.NewVector(0, -1, 0)},
+		{tuples.NewPoint(0, 1, 0.5), tuples.NewVector(0, -1, 0)},
+		{tuples.NewPoint(0, 2, 0), tuples.NewVector(0, 1, 0)},
+		{tuples.NewPoint(0.5, 2, 0), tuples.NewVector(0, 1, 0)},
+		{tuples.NewPoint(0, 2, 0.5), tuples.NewVector(0, 1, 0)},
+	}
+
+	for _, tc := range cases {
+		if got := c.LocalNormalAt(tc.point, Intersection{}); got.IsEquivalentTo(tc.want) == false {
+			t.Errorf("Expected cap normal at %v on cylinder.\nGot  %v;\nWant %v;", tc.point, got, tc.want)
+		}
+	}
+}
+
+func TestClosedCylinderIntersectionsAreSorted(t *testing.T) {
+	c := NewCylinder()
+	c.Minimum = 1
+	c.Maximum = 2
+	c.Closed = true
+	r := rays.NewRay(tuples.NewPoint(0, 3, 0.5), tuples.NewVector(0, -1, 0.4).Normalize())
+	got := c.LocalIntersect(r)
+
+	if len(got) != 2 || !tuples.Equals(got[0].T, 1.07703) || !tuples.Equals(got[1].T, 1.34629) {
+		t.Errorf("Expected ray through the cap to hit at %v then %v. Got %v", 1.07703, 1.34629, got)
+	}
+
+	if hit, ok := got.Hit(); !ok || !tuples.Equals(hit.T, 1.07703) {
+		t.Errorf("Expected hit to be the cap at %v. Got %v", 1.07703, hit)
+	}
+}
@@ -0,0 +1,46 @@
+package shapes
+
+import (
+	"github.com/riavalon/ray_tracer/rays"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+// Group struct is a shape made up of other shapes. The group's transform
+// is applied on top of the transform of each of its children, so the
+// whole group can be moved around as one object.
+type Group struct {
+	base
+	children []Shape
+}
+
+// Children returns the shapes that belong to the group.
+func (g *Group) Children() []Shape {
+	return g.children
+}
+
+// AddChild adds the shape to the group, making the group its parent.
+func (g *Group) AddChild(s Shape) {
+	s.SetParent(g)
+	g.children = append(g.children, s)
+}
+
+// LocalIntersect intersects the object space ray with every child of the
+// group, returning all of the intersections sorted by t.
+func (g *Group) LocalIntersect(r rays.Ray) Intersections {
+	var xs []Intersection
+	for _, child := range g.children {
+		xs = append(xs, Intersect(child, r)...)
+	}
+	return NewIntersections(xs...)
+}
+
+// LocalNormalAt panics, since a group has no surface of its own. Normals
+// are always found on the child that was hit.
+func (g *Group) LocalNormalAt(p tuples.Point, hit Intersection) tuples.Vector {
+	panic("Cannot find the normal of a group, use the normal of a child instead")
+}
+
+// NewGroup creates an empty group with the identity matrix as its transform.
+func NewGroup() *Group {
+	return &Group{base: newBase()}
+}
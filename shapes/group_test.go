@@ -0,0 +1,153 @@
+package shapes
+
+import (
+	"math"
+	"testing"
+
+	"github.com/riavalon/ray_tracer/matrix"
+	"github.com/riavalon/ray_tracer/rays"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+func TestNewGroupIsEmpty(t *testing.T) {
+	g := NewGroup()
+
+	if len(g.Children()) != 0 {
+		t.Errorf("Expected new group to have no children. Got %v", g.Children())
+	}
+
+	if g.Transform().IsEquivalentTo(matrix.Identity()) == false {
+		t.Errorf("Expected default transform to be the identity matrix. Got %v", g.Transform())
+	}
+}
+
+func TestShapeHasNoParentByDefault(t *testing.T) {
+	if p := newTestShape().Parent(); p != nil {
+		t.Errorf("Expected shape to have no parent. Got %v", p)
+	}
+}
+
+func TestAddChildToGroup(t *testing.T) {
+	g := NewGroup()
+	s := newTestShape()
+	g.AddChild(s)
+
+	if len(g.Children()) != 1 || g.Children()[0] != s {
+		t.Errorf("Expected group to contain child. Got %v", g.Children())
+	}
+
+	if s.Parent() != g {
+		t.Errorf("Expected child parent to be the group. Got %v", s.Parent())
+	}
+}
+
+func TestIntersectEmptyGroup(t *testing.T) {
+	r := rays.NewRay(tuples.NewPoint(0, 0, 0), tuples.NewVector(0, 0, 1))
+
+	if got := NewGroup().LocalIntersect(r); len(got) != 0 {
+		t.Errorf("Expected no intersections with empty group. Got %v", got)
+	}
+}
+
+func TestIntersectNonEmptyGroup(t *testing.T) {
+	g := NewGroup()
+	s1 := NewSphere()
+	s2 := NewSphere()
+	s2.SetTransform(matrix.Translation(0, 0, -3))
+	s3 := NewSphere()
+	s3.SetTransform(matrix.Translation(5, 0, 0))
+	g.AddChild(s1)
+	g.AddChild(s2)
+	g.AddChild(s3)
+
+	r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1))
+	got := g.LocalIntersect(r)
+	want := []Shape{s2, s2, s1, s1}
+
+	if len(got) != len(want) {
+		t.Fatalf("Expected %v intersections. Got %v", len(want), len(got))
+	}
+
+	for i, x := range got {
+		if x.Object != want[i] {
+			t.Errorf("Expected sorted intersections with children. Got %v at %v", x.Object, i)
+		}
+	}
+}
+
+func TestIntersectTransformedGroup(t *testing.T) {
+	g := NewGroup()
+	g.SetTransform(matrix.Scaling(2, 2, 2))
+	s := NewSphere()
+	s.SetTransform(matrix.Translation(5, 0, 0))
+	g.AddChild(s)
+
+	r := rays.NewRay(tuples.NewPoint(10, 0, -10), tuples.NewVector(0, 0, 1))
+	if got := Intersect(g, r); len(got) != 2 {
+		t.Errorf("Expected ray to hit child of transformed group twice. Got %v", got)
+	}
+}
+
+func TestConvertPointFromWorldToObjectSpace(t *testing.T) {
+	g1 := NewGroup()
+	g1.SetTransform(matrix.RotationY(math.Pi / 2))
+	g2 := NewGroup()
+	g2.SetTransform(matrix.Scaling(2, 2, 2))
+	g1.AddChild(g2)
+	s := NewSphere()
+	s.SetTransform(matrix.Translation(5, 0, 0))
+	g2.AddChild(s)
+
+	got := WorldToObject(s, tuples.NewPoint(-2, 0, -10))
+	want := tuples.NewPoint(0, 0, -1)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected point in object space.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestConvertNormalFromObjectToWorldSpace(t *testing.T) {
+	g1 := NewGroup()
+	g1.SetTransform(matrix.RotationY(math.Pi / 2))
+	g2 := NewGroup()
+	g2.SetTransform(matrix.Scaling(1, 2, 3))
+	g1.AddChild(g2)
+	s := NewSphere()
+	s.SetTransform(matrix.Translation(5, 0, 0))
+	g2.AddChild(s)
+
+	n := math.Sqrt(3) / 3
+	got := NormalToWorld(s, tuples.NewVector(n, n, n))
+	want := tuples.NewVector(0.2857, 0.4286, -0.8571)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected normal in world space.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestNormalOnChildObject(t *testing.T) {
+	g1 := NewGroup()
+	g1.SetTransform(matrix.RotationY(math.Pi / 2))
+	g2 := NewGroup()
+	g2.SetTransform(matrix.Scaling(1, 2, 3))
+	g1.AddChild(g2)
+	s := NewSphere()
+	s.SetTransform(matrix.Translation(5, 0, 0))
+	g2.AddChild(s)
+
+	got := NormalAt(s, tuples.NewPoint(1.7321, 1.1547, -5.5774), Intersection{})
+	want := tuples.NewVector(0.2857, 0.4286, -0.8571)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected normal on child object.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestGroupNormalPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected finding the normal of a group to panic")
+		}
+	}()
+	NewGroup().LocalNormalAt(tuples.NewPoint(0, 0, 0), Intersection{})
+}
@@ -0,0 +1,59 @@
+package shapes
+
+import "sort"
+
+// Intersection struct records the distance t along a ray where the ray
+// crossed the surface of an object. U and V hold the barycentric
+// coordinates of the hit on a triangle, and are zero for other shapes.
+type Intersection struct {
+	T      float64
+	Object Shape
+	U      float64
+	V      float64
+}
+
+// Intersections is a collection of intersections, kept sorted by t.
+type Intersections []Intersection
+
+// Hit returns the visible intersection, which is the intersection with the
+// lowest non-negative t. Second return value is false if every intersection
+// is behind the ray. The intersections do not need to be sorted.
+func (xs Intersections) Hit() (Intersection, bool) {
+	hit, found := Intersection{}, false
+	for _, x := range xs {
+		if x.T >= 0 && (!found || x.T < hit.T) {
+			hit, found = x, true
+		}
+	}
+	return hit, found
+}
+
+// NewIntersection creates an intersection at distance t for the given object.
+func NewIntersection(t float64, object Shape) Intersection {
+	return Intersection{
+		T:      t,
+		Object: object,
+	}
+}
+
+// NewIntersectionWithUV creates an intersection at distance t for the given
+// object, recording where on the object's surface the hit happened.
+func NewIntersectionWithUV(t float64, object Shape, u, v float64) Intersection {
+	return Intersection{
+		T:      t,
+		Object: object,
+		U:      u,
+		V:      v,
+	}
+}
+
+// NewIntersections collects the given intersections, sorting them by t in
+// ascending order.
+func NewIntersections(xs ...Intersection) Intersections {
+	sorted := make(Intersections, len(xs))
+	copy(sorted, xs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].T < sorted[j].T
+	})
+	return sorted
+}
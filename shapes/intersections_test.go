@@ -0,0 +1,89 @@
+package shapes
+
+import "testing"
+
+func TestNewIntersection(t *testing.T) {
+	s := NewSphere()
+	i := NewIntersection(3.5, s)
+
+	if i.T != 3.5 {
+		t.Errorf("Intersection should have init t. Got %v; Want %v", i.T, 3.5)
+	}
+
+	if i.Object != s {
+		t.Errorf("Intersection should have init object. Got %v; Want %v", i.Object, s)
+	}
+}
+
+func TestIntersectionsAreSorted(t *testing.T) {
+	s := NewSphere()
+	xs := NewIntersections(
+		NewIntersection(5, s),
+		NewIntersection(-3, s),
+		NewIntersection(2, s),
+	)
+	want := []float64{-3, 2, 5}
+
+	for i, x := range xs {
+		if x.T != want[i] {
+			t.Errorf("Expected intersections sorted by t. Got %v at %v; Want %v", x.T, i, want[i])
+		}
+	}
+}
+
+func TestHitWhenAllIntersectionsArePositive(t *testing.T) {
+	s := NewSphere()
+	i1 := NewIntersection(1, s)
+	i2 := NewIntersection(2, s)
+	got, ok := NewIntersections(i2, i1).Hit()
+
+	if !ok || got != i1 {
+		t.Errorf("Expected hit to be lowest intersection. Got %v; Want %v", got, i1)
+	}
+}
+
+func TestHitWhenSomeIntersectionsAreNegative(t *testing.T) {
+	s := NewSphere()
+	i1 := NewIntersection(-1, s)
+	i2 := NewIntersection(1, s)
+	got, ok := NewIntersections(i2, i1).Hit()
+
+	if !ok || got != i2 {
+		t.Errorf("Expected hit to skip negative intersections. Got %v; Want %v", got, i2)
+	}
+}
+
+func TestHitWhenAllIntersectionsAreNegative(t *testing.T) {
+	s := NewSphere()
+	got, ok := NewIntersections(NewIntersection(-2, s), NewIntersection(-1, s)).Hit()
+
+	if ok {
+		t.Errorf("Expected no hit when every intersection is negative. Got %v", got)
+	}
+}
+
+func TestHitIsLowestNonNegativeIntersection(t *testing.T) {
+	s := NewSphere()
+	i1 := NewIntersection(5, s)
+	i2 := NewIntersection(7, s)
+	i3 := NewIntersection(-3, s)
+	i4 := NewIntersection(2, s)
+	got, ok := NewIntersections(i1, i2, i3, i4).Hit()
+
+	if !ok || got != i4 {
+		t.Errorf("Expected hit to be lowest non-negative intersection. Got %v; Want %v", got, i4)
+	}
+}
+
+func TestHitOfUnsortedIntersections(t *testing.T) {
+	s := NewSphere()
+	i1 := NewIntersection(5, s)
+	i2 := NewIntersection(-3, s)
+	i3 := NewIntersection(2, s)
+	i4 := NewIntersection(7, s)
+	got, ok := Intersections{i1, i2, i3, i4}.Hit()
+
+	if !ok || got != i3 {
+		t.Errorf("Expected hit to be lowest non-negative intersection of unsorted list. Got %v; Want %v", got, i3)
+	}
+}
@@ -0,0 +1,37 @@
+package shapes
+
+import (
+	"math"
+
+	"github.com/riavalon/ray_tracer/rays"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+// Plane struct represents an infinite flat surface. In object space the
+// plane lies on the xz plane, passing through the origin.
+type Plane struct {
+	base
+}
+
+// LocalIntersect finds where the object space ray crosses the plane.
+// Rays parallel to the plane, including rays within the plane, never
+// intersect it.
+func (pl *Plane) LocalIntersect(r rays.Ray) Intersections {
+	if math.Abs(r.Direction.Y) < tuples.EPSILON {
+		return Intersections{}
+	}
+
+	t := -r.Origin.Y / r.Direction.Y
+	return Intersections{NewIntersection(t, pl)}
+}
+
+// LocalNormalAt returns the normal of the plane, which points straight
+// up everywhere on its surface.
+func (pl *Plane) LocalNormalAt(p tuples.Point, hit Intersection) tuples.Vector {
+	return tuples.NewVector(0, 1, 0)
+}
+
+// NewPlane creates a plane with the identity matrix as its transform.
+func NewPlane() *Plane {
+	return &Plane{base: newBase()}
+}
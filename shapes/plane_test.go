@@ -0,0 +1,74 @@
+package shapes
+
+import (
+	"math"
+	"testing"
+
+	"github.com/riavalon/ray_tracer/matrix"
+	"github.com/riavalon/ray_tracer/rays"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+func TestPlaneNormalIsConstant(t *testing.T) {
+	p := NewPlane()
+	want := tuples.NewVector(0, 1, 0)
+
+	for _, point := range []tuples.Point{
+		tuples.NewPoint(0, 0, 0),
+		tuples.NewPoint(10, 0, -10),
+		tuples.NewPoint(-5, 0, 150),
+	} {
+		if got := p.LocalNormalAt(point, Intersection{}); got.IsEquivalentTo(want) == false {
+			t.Errorf("Expected constant normal on plane. Got %v; Want %v", got, want)
+		}
+	}
+}
+
+func TestIntersectRayParallelToPlane(t *testing.T) {
+	r := rays.NewRay(tuples.NewPoint(0, 10, 0), tuples.NewVector(0, 0, 1))
+	got := NewPlane().LocalIntersect(r)
+
+	if len(got) != 0 {
+		t.Errorf("Expected parallel ray to miss plane. Got %v", got)
+	}
+}
+
+func TestIntersectCoplanarRay(t *testing.T) {
+	r := rays.NewRay(tuples.NewPoint(0, 0, 0), tuples.NewVector(0, 0, 1))
+	got := NewPlane().LocalIntersect(r)
+
+	if len(got) != 0 {
+		t.Errorf("Expected coplanar ray to miss plane. Got %v", got)
+	}
+}
+
+func TestRayIntersectingPlaneFromAbove(t *testing.T) {
+	p := NewPlane()
+	r := rays.NewRay(tuples.NewPoint(0, 1, 0), tuples.NewVector(0, -1, 0))
+	got := p.LocalIntersect(r)
+
+	if len(got) != 1 || got[0].T != 1 || got[0].Object != p {
+		t.Errorf("Expected ray from above to hit plane once at t 1. Got %v", got)
+	}
+}
+
+func TestRayIntersectingPlaneFromBelow(t *testing.T) {
+	p := NewPlane()
+	r := rays.NewRay(tuples.NewPoint(0, -1, 0), tuples.NewVector(0, 1, 0))
+	got := p.LocalIntersect(r)
+
+	if len(got) != 1 || got[0].T != 1 || got[0].Object != p {
+		t.Errorf("Expected ray from below to hit plane once at t 1. Got %v", got)
+	}
+}
+
+func TestNormalOnTransformedPlane(t *testing.T) {
+	p := NewPlane()
+	p.SetTransform(matrix.RotationZ(math.Pi / 2))
+	got := NormalAt(p, tuples.NewPoint(0, 0, 0), Intersection{})
+	want := tuples.NewVector(-1, 0, 0)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected normal of rotated plane in world space.\nGot  %v;\nWant %v;", got, want)
+	}
+}
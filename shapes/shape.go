@@ -0,0 +1,144 @@
+package shapes
+
+import (
+	"github.com/riavalon/ray_tracer/materials"
+	"github.com/riavalon/ray_tracer/matrix"
+	"github.com/riavalon/ray_tracer/rays"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+// Shape interface is implemented by every object that can be intersected
+// by a ray. Shapes only need to know how to intersect a ray and find a
+// normal in their own object space, Intersect and NormalAt take care of
+// moving between world space and object space, including the transforms
+// of any groups or CSG shapes the shape belongs to.
+type Shape interface {
+	Transform() matrix.Matrix
+	InverseTransform() matrix.Matrix
+	SetTransform(m matrix.Matrix) error
+	Material() materials.Material
+	SetMaterial(m materials.Material)
+	Parent() Shape
+	SetParent(p Shape)
+	LocalIntersect(r rays.Ray) Intersections
+	LocalNormalAt(p tuples.Point, hit Intersection) tuples.Vector
+}
+
+// Intersect converts the ray into the object space of the shape and
+// returns the intersections found by the shape.
+func Intersect(s Shape, r rays.Ray) Intersections {
+	return s.LocalIntersect(r.Transform(s.InverseTransform()))
+}
+
+// NormalAt finds the surface normal of the shape at the given world space
+// point. The normal is calculated in object space and moved back into world
+// space using the transpose of the inverse transform. The hit is passed
+// through for shapes that need more than the point to find the normal,
+// such as smooth triangles.
+func NormalAt(s Shape, p tuples.Point, hit Intersection) tuples.Vector {
+	localPoint := WorldToObject(s, p)
+	localNormal := s.LocalNormalAt(localPoint, hit)
+	return NormalToWorld(s, localNormal)
+}
+
+// WorldToObject converts a world space point into the object space of the
+// shape, passing through the object space of every parent on the way.
+func WorldToObject(s Shape, p tuples.Point) tuples.Point {
+	if parent := s.Parent(); parent != nil {
+		p = WorldToObject(parent, p)
+	}
+	return s.InverseTransform().MultiplyPoint(p)
+}
+
+// NormalToWorld converts a normal in the object space of the shape into
+// world space, passing through the object space of every parent on the
+// way. The returned normal is normalized.
+func NormalToWorld(s Shape, n tuples.Vector) tuples.Vector {
+	n = s.InverseTransform().Transpose().MultiplyVector(n).Normalize()
+	if parent := s.Parent(); parent != nil {
+		n = NormalToWorld(parent, n)
+	}
+	return n
+}
+
+// base struct holds the transform, material and parent shared by every
+// shape. Shapes embed it to satisfy the non-geometry parts of the Shape
+// interface.
+type base struct {
+	transform matrix.Matrix
+	inverse   matrix.Matrix
+	material  materials.Material
+	parent    Shape
+}
+
+// Transform returns the transformation matrix applied to the shape.
+func (b *base) Transform() matrix.Matrix {
+	return b.transform
+}
+
+// InverseTransform returns the inverse of the shape's transform. It is
+// calculated once when the transform is set.
+func (b *base) InverseTransform() matrix.Matrix {
+	return b.inverse
+}
+
+// SetTransform updates the transformation matrix applied to the shape.
+// Returns matrix.ErrNotInvertible if the transform cannot be inverted,
+// leaving the existing transform in place.
+func (b *base) SetTransform(m matrix.Matrix) error {
+	inverse, err := m.Inverse()
+	if err != nil {
+		return err
+	}
+	b.transform = m
+	b.inverse = inverse
+	return nil
+}
+
+// Material returns the material of the shape.
+func (b *base) Material() materials.Material {
+	return b.material
+}
+
+// SetMaterial updates the material of the shape.
+func (b *base) SetMaterial(m materials.Material) {
+	b.material = m
+}
+
+// Parent returns the group or CSG shape the shape belongs to, or nil if
+// it does not belong to one.
+func (b *base) Parent() Shape {
+	return b.parent
+}
+
+// SetParent updates the shape the shape belongs to. Use (*Group).AddChild
+// or NewCSG rather than calling this directly so the parent knows about
+// the shape too.
+func (b *base) SetParent(p Shape) {
+	b.parent = p
+}
+
+func newBase() base {
+	return base{
+		transform: matrix.Identity(),
+		inverse:   matrix.Identity(),
+		material:  materials.NewMaterial(),
+	}
+}
+
+// Includes checks if other is the shape itself, or is contained anywhere
+// inside it when the shape is a group or CSG shape.
+func Includes(s, other Shape) bool {
+	switch shape := s.(type) {
+	case *Group:
+		for _, child := range shape.children {
+			if Includes(child, other) {
+				return true
+			}
+		}
+		return false
+	case *CSG:
+		return Includes(shape.Left, other) || Includes(shape.Right, other)
+	}
+	return s == other
+}
@@ -0,0 +1,125 @@
+package shapes
+
+import (
+	"math"
+	"testing"
+
+	"github.com/riavalon/ray_tracer/canvas"
+	"github.com/riavalon/ray_tracer/materials"
+	"github.com/riavalon/ray_tracer/matrix"
+	"github.com/riavalon/ray_tracer/rays"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+// testShape records the object space ray it was intersected with so the
+// conversion from world space can be checked.
+type testShape struct {
+	base
+	savedRay rays.Ray
+}
+
+func (s *testShape) LocalIntersect(r rays.Ray) Intersections {
+	s.savedRay = r
+	return Intersections{}
+}
+
+func (s *testShape) LocalNormalAt(p tuples.Point, hit Intersection) tuples.Vector {
+	return tuples.NewVector(p.X, p.Y, p.Z)
+}
+
+func newTestShape() *testShape {
+	return &testShape{base: newBase()}
+}
+
+func TestShapeDefaultTransform(t *testing.T) {
+	s := newTestShape()
+
+	if s.Transform().IsEquivalentTo(matrix.Identity()) == false {
+		t.Errorf("Expected default transform to be the identity matrix. Got %v", s.Transform())
+	}
+}
+
+func TestSetShapeTransformUpdatesInverse(t *testing.T) {
+	s := newTestShape()
+	s.SetTransform(matrix.Translation(2, 3, 4))
+	want := matrix.Translation(-2, -3, -4)
+
+	if s.InverseTransform().IsEquivalentTo(want) == false {
+		t.Errorf("Expected inverse transform to be updated.\nGot  %v;\nWant %v;", s.InverseTransform(), want)
+	}
+}
+
+func TestShapeDefaultMaterial(t *testing.T) {
+	s := newTestShape()
+
+	if s.Material() != materials.NewMaterial() {
+		t.Errorf("Expected default material. Got %v", s.Material())
+	}
+}
+
+func TestSetShapeMaterial(t *testing.T) {
+	s := newTestShape()
+	m := materials.NewMaterial()
+	m.Colour = canvas.NewColour(1, 0.2, 1)
+	s.SetMaterial(m)
+
+	if s.Material() != m {
+		t.Errorf("Expected material to be updated. Got %v; Want %v", s.Material(), m)
+	}
+}
+
+func TestIntersectScaledShapeWithRay(t *testing.T) {
+	r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1))
+	s := newTestShape()
+	s.SetTransform(matrix.Scaling(2, 2, 2))
+	Intersect(s, r)
+	wantOrigin := tuples.NewPoint(0, 0, -2.5)
+	wantDirection := tuples.NewVector(0, 0, 0.5)
+
+	if s.savedRay.Origin.IsEquivalentTo(wantOrigin) == false {
+		t.Errorf("Expected ray origin in object space. Got %v; Want %v", s.savedRay.Origin, wantOrigin)
+	}
+
+	if s.savedRay.Direction.IsEquivalentTo(wantDirection) == false {
+		t.Errorf("Expected ray direction in object space. Got %v; Want %v", s.savedRay.Direction, wantDirection)
+	}
+}
+
+func TestIntersectTranslatedShapeWithRay(t *testing.T) {
+	r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1))
+	s := newTestShape()
+	s.SetTransform(matrix.Translation(5, 0, 0))
+	Intersect(s, r)
+	wantOrigin := tuples.NewPoint(-5, 0, -5)
+	wantDirection := tuples.NewVector(0, 0, 1)
+
+	if s.savedRay.Origin.IsEquivalentTo(wantOrigin) == false {
+		t.Errorf("Expected ray origin in object space. Got %v; Want %v", s.savedRay.Origin, wantOrigin)
+	}
+
+	if s.savedRay.Direction.IsEquivalentTo(wantDirection) == false {
+		t.Errorf("Expected ray direction in object space. Got %v; Want %v", s.savedRay.Direction, wantDirection)
+	}
+}
+
+func TestNormalOnTranslatedShape(t *testing.T) {
+	s := newTestShape()
+	s.SetTransform(matrix.Translation(0, 1, 0))
+	got := NormalAt(s, tuples.NewPoint(0, 1.70711, -0.70711), Intersection{})
+	want := tuples.NewVector(0, 0.70711, -0.70711)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected normal on translated shape.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestNormalOnTransformedShape(t *testing.T) {
+	s := newTestShape()
+	s.SetTransform(matrix.Identity().RotateZ(math.Pi/5).Scale(1, 0.5, 1))
+	got := NormalAt(s, tuples.NewPoint(0, math.Sqrt2/2, -math.Sqrt2/2), Intersection{})
+	want := tuples.NewVector(0, 0.97014, -0.24254)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected normal on transformed shape.\nGot  %v;\nWant %v;", got, want)
+	}
+}
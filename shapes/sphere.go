@@ -0,0 +1,47 @@
+package shapes
+
+import (
+	"math"
+
+	"github.com/riavalon/ray_tracer/rays"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+// Sphere struct represents a unit sphere centred on the origin. The sphere
+// is moved, scaled and rotated into place using its transform.
+type Sphere struct {
+	base
+}
+
+// LocalIntersect finds where the object space ray crosses the surface of
+// the sphere, in increasing order of t. Returns no intersections if the
+// ray misses, and the same t twice if the ray is tangent to the sphere.
+func (s *Sphere) LocalIntersect(r rays.Ray) Intersections {
+	sphereToRay := r.Origin.Subtract(tuples.NewPoint(0, 0, 0))
+
+	a := r.Direction.Dot(r.Direction)
+	b := 2 * r.Direction.Dot(sphereToRay)
+	c := sphereToRay.Dot(sphereToRay) - 1
+
+	discriminant := b*b - 4*a*c
+	if discriminant < 0 {
+		return Intersections{}
+	}
+
+	root := math.Sqrt(discriminant)
+	return Intersections{
+		NewIntersection((-b-root)/(2*a), s),
+		NewIntersection((-b+root)/(2*a), s),
+	}
+}
+
+// LocalNormalAt finds the normal of the sphere at the given object space
+// point, which points straight out from the centre.
+func (s *Sphere) LocalNormalAt(p tuples.Point, hit Intersection) tuples.Vector {
+	return p.Subtract(tuples.NewPoint(0, 0, 0))
+}
+
+// NewSphere creates a unit sphere with the identity matrix as its transform.
+func NewSphere() *Sphere {
+	return &Sphere{base: newBase()}
+}
@@ -0,0 +1,174 @@
+package shapes
+
+import (
+	"math"
+	"testing"
+
+	"github.com/riavalon/ray_tracer/matrix"
+	"github.com/riavalon/ray_tracer/rays"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+func TestRayIntersectsSphereAtTwoPoints(t *testing.T) {
+	r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1))
+	got := Intersect(NewSphere(), r)
+	want := []float64{4, 6}
+
+	if len(got) != 2 || got[0].T != want[0] || got[1].T != want[1] {
+		t.Errorf("Expected ray to intersect sphere twice. Got %v; Want %v", got, want)
+	}
+}
+
+func TestRayIntersectsSphereAtTangent(t *testing.T) {
+	r := rays.NewRay(tuples.NewPoint(0, 1, -5), tuples.NewVector(0, 0, 1))
+	got := Intersect(NewSphere(), r)
+	want := []float64{5, 5}
+
+	if len(got) != 2 || got[0].T != want[0] || got[1].T != want[1] {
+		t.Errorf("Expected tangent ray to intersect sphere at the same point twice. Got %v; Want %v", got, want)
+	}
+}
+
+func TestIntersectSetsObjectOnIntersection(t *testing.T) {
+	r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1))
+	s := NewSphere()
+	got := Intersect(s, r)
+
+	if len(got) != 2 || got[0].Object != s || got[1].Object != s {
+		t.Errorf("Expected intersections to record the sphere. Got %v", got)
+	}
+}
+
+func TestRayMissesSphere(t *testing.T) {
+	r := rays.NewRay(tuples.NewPoint(0, 2, -5), tuples.NewVector(0, 0, 1))
+	got := Intersect(NewSphere(), r)
+
+	if len(got) != 0 {
+		t.Errorf("Expected ray to miss sphere. Got %v", got)
+	}
+}
+
+func TestRayOriginatesInsideSphere(t *testing.T) {
+	r := rays.NewRay(tuples.NewPoint(0, 0, 0), tuples.NewVector(0, 0, 1))
+	got := Intersect(NewSphere(), r)
+	want := []float64{-1, 1}
+
+	if len(got) != 2 || got[0].T != want[0] || got[1].T != want[1] {
+		t.Errorf("Expected intersection behind and in front of ray. Got %v; Want %v", got, want)
+	}
+}
+
+func TestSphereIsBehindRay(t *testing.T) {
+	r := rays.NewRay(tuples.NewPoint(0, 0, 5), tuples.NewVector(0, 0, 1))
+	got := Intersect(NewSphere(), r)
+	want := []float64{-6, -4}
+
+	if len(got) != 2 || got[0].T != want[0] || got[1].T != want[1] {
+		t.Errorf("Expected both intersections behind ray. Got %v; Want %v", got, want)
+	}
+}
+
+func TestSphereDefaultTransform(t *testing.T) {
+	s := NewSphere()
+
+	if s.Transform().IsEquivalentTo(matrix.Identity()) == false {
+		t.Errorf("Expected default transform to be the identity matrix. Got %v", s.Transform())
+	}
+}
+
+func TestSetSphereTransform(t *testing.T) {
+	s := NewSphere()
+	transform := matrix.Translation(2, 3, 4)
+
+	if err := s.SetTransform(transform); err != nil {
+		t.Fatalf("Expected no error setting an invertible transform. Got %v", err)
+	}
+
+	if s.Transform().IsEquivalentTo(transform) == false {
+		t.Errorf("Expected sphere transform to be updated.\nGot  %v;\nWant %v;", s.Transform(), transform)
+	}
+}
+
+func TestSetSphereTransformRejectsSingularMatrix(t *testing.T) {
+	s := NewSphere()
+
+	if err := s.SetTransform(matrix.Scaling(0, 1, 1)); err != matrix.ErrNotInvertible {
+		t.Errorf("Expected ErrNotInvertible for singular transform. Got %v", err)
+	}
+
+	if s.Transform().IsEquivalentTo(matrix.Identity()) == false {
+		t.Errorf("Expected transform to be unchanged after error. Got %v", s.Transform())
+	}
+}
+
+func TestIntersectScaledSphere(t *testing.T) {
+	r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1))
+	s := NewSphere()
+	s.SetTransform(matrix.Scaling(2, 2, 2))
+	got := Intersect(s, r)
+	want := []float64{3, 7}
+
+	if len(got) != 2 || got[0].T != want[0] || got[1].T != want[1] {
+		t.Errorf("Expected ray to intersect scaled sphere. Got %v; Want %v", got, want)
+	}
+}
+
+func TestIntersectTranslatedSphere(t *testing.T) {
+	r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1))
+	s := NewSphere()
+	s.SetTransform(matrix.Translation(5, 0, 0))
+	got := Intersect(s, r)
+
+	if len(got) != 0 {
+		t.Errorf("Expected ray to miss translated sphere. Got %v", got)
+	}
+}
+
+func TestSphereNormalOnAxes(t *testing.T) {
+	s := NewSphere()
+	cases := []struct {
+		point tuples.Point
+		want  tuples.Vector
+	}{
+		{tuples.NewPoint(1, 0, 0), tuples.NewVector(1, 0, 0)},
+		{tuples.NewPoint(0, 1, 0), tuples.NewVector(0, 1, 0)},
+		{tuples.NewPoint(0, 0, 1), tuples.NewVector(0, 0, 1)},
+	}
+
+	for _, c := range cases {
+		if got := NormalAt(s, c.point, Intersection{}); got.IsEquivalentTo(c.want) == false {
+			t.Errorf("Expected normal on axis.\nGot  %v;\nWant %v;", got, c.want)
+		}
+	}
+}
+
+func TestSphereNormalIsNormalized(t *testing.T) {
+	n := math.Sqrt(3) / 3
+	got := NormalAt(NewSphere(), tuples.NewPoint(n, n, n), Intersection{})
+
+	if got.IsEquivalentTo(got.Normalize()) == false {
+		t.Errorf("Expected normal to be a unit vector. Got %v", got)
+	}
+}
+
+func TestNormalOnTranslatedSphere(t *testing.T) {
+	s := NewSphere()
+	s.SetTransform(matrix.Translation(0, 1, 0))
+	got := NormalAt(s, tuples.NewPoint(0, 1.70711, -0.70711), Intersection{})
+	want := tuples.NewVector(0, 0.70711, -0.70711)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected normal on translated sphere.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestNormalOnTransformedSphere(t *testing.T) {
+	s := NewSphere()
+	s.SetTransform(matrix.Identity().RotateZ(math.Pi/5).Scale(1, 0.5, 1))
+	got := NormalAt(s, tuples.NewPoint(0, math.Sqrt2/2, -math.Sqrt2/2), Intersection{})
+	want := tuples.NewVector(0, 0.97014, -0.24254)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected normal on transformed sphere.\nGot  %v;\nWant %v;", got, want)
+	}
+}
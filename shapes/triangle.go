@@ -0,0 +1,130 @@
+package shapes
+
+import (
+	"math"
+
+	"github.com/riavalon/ray_tracer/rays"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+// Triangle struct represents a flat triangle between three points. The
+// edges and normal are calculated once when the triangle is created.
+type Triangle struct {
+	base
+	P1     tuples.Point
+	P2     tuples.Point
+	P3     tuples.Point
+	E1     tuples.Vector
+	E2     tuples.Vector
+	Normal tuples.Vector
+}
+
+// LocalIntersect finds where the object space ray crosses the triangle
+// using the Möller–Trumbore algorithm.
+func (tri *Triangle) LocalIntersect(r rays.Ray) Intersections {
+	t, u, v, ok := intersectTriangle(r, tri.P1, tri.E1, tri.E2)
+	if !ok {
+		return Intersections{}
+	}
+	return Intersections{NewIntersectionWithUV(t, tri, u, v)}
+}
+
+// LocalNormalAt returns the precomputed normal of the triangle, which is
+// the same everywhere on its surface.
+func (tri *Triangle) LocalNormalAt(p tuples.Point, hit Intersection) tuples.Vector {
+	return tri.Normal
+}
+
+// NewTriangle creates a triangle between the given points with the
+// identity matrix as its transform.
+func NewTriangle(p1, p2, p3 tuples.Point) *Triangle {
+	e1 := p2.Subtract(p1)
+	e2 := p3.Subtract(p1)
+	return &Triangle{
+		base:   newBase(),
+		P1:     p1,
+		P2:     p2,
+		P3:     p3,
+		E1:     e1,
+		E2:     e2,
+		Normal: e2.Cross(e1).Normalize(),
+	}
+}
+
+// SmoothTriangle struct represents a triangle with a normal at each of
+// its points. Normals across the surface are interpolated between them,
+// which makes meshes look smooth without needing more triangles.
+type SmoothTriangle struct {
+	base
+	P1 tuples.Point
+	P2 tuples.Point
+	P3 tuples.Point
+	N1 tuples.Vector
+	N2 tuples.Vector
+	N3 tuples.Vector
+	E1 tuples.Vector
+	E2 tuples.Vector
+}
+
+// LocalIntersect finds where the object space ray crosses the triangle,
+// recording the u and v of the hit on the intersection.
+func (tri *SmoothTriangle) LocalIntersect(r rays.Ray) Intersections {
+	t, u, v, ok := intersectTriangle(r, tri.P1, tri.E1, tri.E2)
+	if !ok {
+		return Intersections{}
+	}
+	return Intersections{NewIntersectionWithUV(t, tri, u, v)}
+}
+
+// LocalNormalAt interpolates the normals of the triangle's points using
+// the u and v stored on the hit.
+func (tri *SmoothTriangle) LocalNormalAt(p tuples.Point, hit Intersection) tuples.Vector {
+	return tri.N2.MultiplyByScalar(hit.U).
+		Add(tri.N3.MultiplyByScalar(hit.V)).
+		Add(tri.N1.MultiplyByScalar(1 - hit.U - hit.V))
+}
+
+// NewSmoothTriangle creates a triangle between the given points, using the
+// given normals at each point, with the identity matrix as its transform.
+func NewSmoothTriangle(p1, p2, p3 tuples.Point, n1, n2, n3 tuples.Vector) *SmoothTriangle {
+	return &SmoothTriangle{
+		base: newBase(),
+		P1:   p1,
+		P2:   p2,
+		P3:   p3,
+		N1:   n1,
+		N2:   n2,
+		N3:   n3,
+		E1:   p2.Subtract(p1),
+		E2:   p3.Subtract(p1),
+	}
+}
+
+// intersectTriangle finds where the ray crosses the triangle with the given
+// first point and edges. Returns the t of the hit along with the barycentric
+// u and v of where it landed. Last return value is false if the ray misses.
+func intersectTriangle(r rays.Ray, p1 tuples.Point, e1, e2 tuples.Vector) (t, u, v float64, ok bool) {
+	dirCrossE2 := r.Direction.Cross(e2)
+	det := e1.Dot(dirCrossE2)
+
+	// The ray is parallel to the triangle
+	if math.Abs(det) < tuples.EPSILON {
+		return 0, 0, 0, false
+	}
+
+	f := 1 / det
+	p1ToOrigin := r.Origin.Subtract(p1)
+	u = f * p1ToOrigin.Dot(dirCrossE2)
+	if u < 0 || u > 1 {
+		return 0, 0, 0, false
+	}
+
+	originCrossE1 := p1ToOrigin.Cross(e1)
+	v = f * r.Direction.Dot(originCrossE1)
+	if v < 0 || u+v > 1 {
+		return 0, 0, 0, false
+	}
+
+	t = f * e2.Dot(originCrossE1)
+	return t, u, v, true
+}
@@ -0,0 +1,117 @@
+package shapes
+
+import (
+	"testing"
+
+	"github.com/riavalon/ray_tracer/rays"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+func newTestTriangle() *Triangle {
+	return NewTriangle(
+		tuples.NewPoint(0, 1, 0),
+		tuples.NewPoint(-1, 0, 0),
+		tuples.NewPoint(1, 0, 0),
+	)
+}
+
+func newTestSmoothTriangle() *SmoothTriangle {
+	return NewSmoothTriangle(
+		tuples.NewPoint(0, 1, 0),
+		tuples.NewPoint(-1, 0, 0),
+		tuples.NewPoint(1, 0, 0),
+		tuples.NewVector(0, 1, 0),
+		tuples.NewVector(-1, 0, 0),
+		tuples.NewVector(1, 0, 0),
+	)
+}
+
+func TestNewTriangle(t *testing.T) {
+	tri := newTestTriangle()
+
+	if want := tuples.NewVector(-1, -1, 0); tri.E1.IsEquivalentTo(want) == false {
+		t.Errorf("Expected first edge of triangle. Got %v; Want %v", tri.E1, want)
+	}
+
+	if want := tuples.NewVector(1, -1, 0); tri.E2.IsEquivalentTo(want) == false {
+		t.Errorf("Expected second edge of triangle. Got %v; Want %v", tri.E2, want)
+	}
+
+	if want := tuples.NewVector(0, 0, -1); tri.Normal.IsEquivalentTo(want) == false {
+		t.Errorf("Expected normal of triangle. Got %v; Want %v", tri.Normal, want)
+	}
+}
+
+func TestTriangleNormalIsConstant(t *testing.T) {
+	tri := newTestTriangle()
+
+	for _, p := range []tuples.Point{
+		tuples.NewPoint(0, 0.5, 0),
+		tuples.NewPoint(-0.5, 0.75, 0),
+		tuples.NewPoint(0.5, 0.25, 0),
+	} {
+		if got := tri.LocalNormalAt(p, Intersection{}); got.IsEquivalentTo(tri.Normal) == false {
+			t.Errorf("Expected constant normal on triangle. Got %v; Want %v", got, tri.Normal)
+		}
+	}
+}
+
+func TestRayMissesTriangle(t *testing.T) {
+	tri := newTestTriangle()
+	cases := []struct {
+		name      string
+		origin    tuples.Point
+		direction tuples.Vector
+	}{
+		{"parallel", tuples.NewPoint(0, -1, -2), tuples.NewVector(0, 1, 0)},
+		{"p1-p3 edge", tuples.NewPoint(1, 1, -2), tuples.NewVector(0, 0, 1)},
+		{"p1-p2 edge", tuples.NewPoint(-1, 1, -2), tuples.NewVector(0, 0, 1)},
+		{"p2-p3 edge", tuples.NewPoint(0, -1, -2), tuples.NewVector(0, 0, 1)},
+	}
+
+	for _, tc := range cases {
+		if got := tri.LocalIntersect(rays.NewRay(tc.origin, tc.direction)); len(got) != 0 {
+			t.Errorf("Expected ray past %v to miss triangle. Got %v", tc.name, got)
+		}
+	}
+}
+
+func TestRayStrikesTriangle(t *testing.T) {
+	tri := newTestTriangle()
+	r := rays.NewRay(tuples.NewPoint(0, 0.5, -2), tuples.NewVector(0, 0, 1))
+	got := tri.LocalIntersect(r)
+
+	if len(got) != 1 || got[0].T != 2 || got[0].Object != tri {
+		t.Errorf("Expected ray to strike triangle once at t 2. Got %v", got)
+	}
+}
+
+func TestIntersectionWithUV(t *testing.T) {
+	tri := newTestSmoothTriangle()
+	i := NewIntersectionWithUV(3.5, tri, 0.2, 0.4)
+
+	if i.U != 0.2 || i.V != 0.4 {
+		t.Errorf("Expected intersection to store u and v. Got %v, %v; Want %v, %v", i.U, i.V, 0.2, 0.4)
+	}
+}
+
+func TestSmoothTriangleIntersectionStoresUV(t *testing.T) {
+	tri := newTestSmoothTriangle()
+	r := rays.NewRay(tuples.NewPoint(-0.2, 0.3, -2), tuples.NewVector(0, 0, 1))
+	got := tri.LocalIntersect(r)
+
+	if len(got) != 1 || !tuples.Equals(got[0].U, 0.45) || !tuples.Equals(got[0].V, 0.25) {
+		t.Errorf("Expected intersection to store u 0.45 and v 0.25. Got %v", got)
+	}
+}
+
+func TestSmoothTriangleInterpolatesNormal(t *testing.T) {
+	tri := newTestSmoothTriangle()
+	i := NewIntersectionWithUV(1, tri, 0.45, 0.25)
+	got := NormalAt(tri, tuples.NewPoint(0, 0, 0), i)
+	want := tuples.NewVector(-0.5547, 0.83205, 0)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected interpolated normal.\nGot  %v;\nWant %v;", got, want)
+	}
+}
@@ -0,0 +1,44 @@
+package tuples
+
+// Point struct represents a location in space. Unlike a Tuple, a point
+// can only be combined with other values in ways that make sense, so
+// mistakes like adding two points together are caught when compiling.
+type Point struct {
+	X float64
+	Y float64
+	Z float64
+}
+
+// Add moves the point in the direction of the given vector, returning
+// the new point.
+func (p Point) Add(v Vector) Point {
+	return NewPoint(p.X+v.X, p.Y+v.Y, p.Z+v.Z)
+}
+
+// Subtract takes another point and returns the vector pointing from the
+// given point to the calling point.
+func (p Point) Subtract(b Point) Vector {
+	return NewVector(p.X-b.X, p.Y-b.Y, p.Z-b.Z)
+}
+
+// SubtractVector moves the point backwards along the given vector,
+// returning the new point.
+func (p Point) SubtractVector(v Vector) Point {
+	return NewPoint(p.X-v.X, p.Y-v.Y, p.Z-v.Z)
+}
+
+// IsEquivalentTo checks if each component of the given point is
+// equivalent to the calling point.
+func (p Point) IsEquivalentTo(b Point) bool {
+	return Equals(p.X, b.X) && Equals(p.Y, b.Y) && Equals(p.Z, b.Z)
+}
+
+// ToTuple converts the point into a tuple with a w of 1.
+func (p Point) ToTuple() Tuple {
+	return CreatePoint(p.X, p.Y, p.Z)
+}
+
+// NewPoint creates a point at the given coordinates.
+func NewPoint(x, y, z float64) Point {
+	return Point{X: x, Y: y, Z: z}
+}
@@ -0,0 +1,77 @@
+package tuples
+
+import "testing"
+
+func TestNewPoint(t *testing.T) {
+	p := NewPoint(4.3, -4.2, 3.1)
+
+	if p.X != 4.3 || p.Y != -4.2 || p.Z != 3.1 {
+		t.Errorf("NewPoint should have init values. Got %v", p)
+	}
+}
+
+func TestAddVectorToPoint(t *testing.T) {
+	p := NewPoint(3, -2, 5)
+	v := NewVector(-2, 3, 1)
+	got := p.Add(v)
+	want := NewPoint(1, 1, 6)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected point moved by vector.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestSubtractPointFromPoint(t *testing.T) {
+	a := NewPoint(3, 2, 1)
+	b := NewPoint(5, 6, 7)
+	got := a.Subtract(b)
+	want := NewVector(-2, -4, -6)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected vector from point - point.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestSubtractVectorFromPointType(t *testing.T) {
+	p := NewPoint(3, 2, 1)
+	v := NewVector(5, 6, 7)
+	got := p.SubtractVector(v)
+	want := NewPoint(-2, -4, -6)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected point from point - vector.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestPointsNotEquivalent(t *testing.T) {
+	a := NewPoint(4.3, -4.2, 3.1)
+	b := NewPoint(4.3, -2.2, 3.1)
+
+	if a.IsEquivalentTo(b) {
+		t.Errorf("Expected points to not be equivalent")
+	}
+}
+
+func TestPointToTupleAndBack(t *testing.T) {
+	p := NewPoint(1, 2, 3)
+	tup := p.ToTuple()
+
+	if tup.IsPoint() == false {
+		t.Errorf("Expected point to convert into a tuple with w of 1. Got %v", tup)
+	}
+
+	got, ok := tup.ToPoint()
+	if ok == false || got.IsEquivalentTo(p) == false {
+		t.Errorf("Expected tuple to convert back into the same point.\nGot  %v;\nWant %v;", got, p)
+	}
+}
+
+func TestVectorTupleIsNotAPoint(t *testing.T) {
+	if _, ok := CreateVector(1, 2, 3).ToPoint(); ok {
+		t.Errorf("Expected converting a vector tuple into a point to fail")
+	}
+
+	if _, ok := CreateTuple(1, 2, 3, 2).ToPoint(); ok {
+		t.Errorf("Expected converting a tuple with w of 2 into a point to fail")
+	}
+}
@@ -21,73 +21,56 @@ type Tuple struct {
 	W float64
 }
 
-// Negate inverts a tuple's values by subtracting from a x0 y0 z0 tuple
-func (t *Tuple) Negate() *Tuple {
-	zero := CreateVector(0, 0, 0)
-	negated := zero.Subtract(t)
-	negated.W = t.W
-	return negated
+// Negate inverts every component of a tuple, including w.
+func (t Tuple) Negate() Tuple {
+	return CreateTuple(-t.X, -t.Y, -t.Z, -t.W)
 }
 
 // Add sums the corresponding values of both tuples and returns a new tuple
-// with the total values
-func (t *Tuple) Add(b *Tuple) *Tuple {
-	return CreatePoint(
-		Calculate(t.X, b.X, Addition),
-		Calculate(t.Y, b.Y, Addition),
-		Calculate(t.Z, b.Z, Addition),
-	)
+// with the total values. The w components are summed too, so a vector plus
+// a vector stays a vector and a point plus a vector stays a point. Adding
+// two points gives a w of 2, which is neither a point nor a vector.
+func (t Tuple) Add(b Tuple) Tuple {
+	return CreateTuple(t.X+b.X, t.Y+b.Y, t.Z+b.Z, t.W+b.W)
 }
 
 // Subtract takes the corresponding values of both tuples and subtracts them
 // returning a new tuple with the resulting values.
-func (t *Tuple) Subtract(b *Tuple) *Tuple {
-	return &Tuple{
-		X: Calculate(t.X, b.X, Subtract),
-		Y: Calculate(t.Y, b.Y, Subtract),
-		Z: Calculate(t.Z, b.Z, Subtract),
-		W: Calculate(t.W, b.W, Subtract),
+func (t Tuple) Subtract(b Tuple) Tuple {
+	return Tuple{
+		X: t.X - b.X,
+		Y: t.Y - b.Y,
+		Z: t.Z - b.Z,
+		W: t.W - b.W,
 	}
 }
 
 // MultiplyByScalar multiplies each component of a tuple by a given scalar
 // returning a new tuple with the product of each component.
-func (t *Tuple) MultiplyByScalar(n float64) *Tuple {
-	tup := CreateTuple(
-		Calculate(n, t.X, Multiply),
-		Calculate(n, t.Y, Multiply),
-		Calculate(n, t.Z, Multiply),
-		t.W,
-	)
-	return tup
+func (t Tuple) MultiplyByScalar(n float64) Tuple {
+	return CreateTuple(n*t.X, n*t.Y, n*t.Z, t.W)
 }
 
-// DivideByScalar works just like (*Tuple).MultiplyByScalar but with a
+// DivideByScalar works just like (Tuple).MultiplyByScalar but with a
 // division operation instead. Returns a new tuple with the resulting values.
-func (t *Tuple) DivideByScalar(n float64) *Tuple {
-	tup := CreateTuple(
-		Calculate(t.X, n, Divide),
-		Calculate(t.Y, n, Divide),
-		Calculate(t.Z, n, Divide),
-		t.W,
-	)
-	return tup
+func (t Tuple) DivideByScalar(n float64) Tuple {
+	return CreateTuple(t.X/n, t.Y/n, t.Z/n, t.W)
 }
 
 // IsPoint checks if tuple is a static point.
-func (t *Tuple) IsPoint() bool {
+func (t Tuple) IsPoint() bool {
 	return t.W == 1.0
 }
 
 // IsVector checks if tuple is a vector.
-func (t *Tuple) IsVector() bool {
+func (t Tuple) IsVector() bool {
 	return t.W == 0
 }
 
 // IsEquivalentTo checks to see if the floating point values of each component
 // in the tuple are equalivalent to each other. If all components are equal,
 // the overall tuples are considered equal as well.
-func (t *Tuple) IsEquivalentTo(b *Tuple) bool {
+func (t Tuple) IsEquivalentTo(b Tuple) bool {
 	result := true
 
 	x := Equals(t.X, b.X)
@@ -106,20 +89,32 @@ func (t *Tuple) IsEquivalentTo(b *Tuple) bool {
 	return result
 }
 
+// ToPoint converts the tuple into a Point, dropping the w component. The
+// second return value is false if the tuple is not a point.
+func (t Tuple) ToPoint() (Point, bool) {
+	return NewPoint(t.X, t.Y, t.Z), t.IsPoint()
+}
+
+// ToVector converts the tuple into a Vector, dropping the w component. The
+// second return value is false if the tuple is not a vector.
+func (t Tuple) ToVector() (Vector, bool) {
+	return NewVector(t.X, t.Y, t.Z), t.IsVector()
+}
+
 // CreateTuple handles creating a tuple. Will eventually handle point vs vector
-func CreateTuple(x, y, z, w float64) *Tuple {
-	return &Tuple{X: x, Y: y, Z: z, W: w}
+func CreateTuple(x, y, z, w float64) Tuple {
+	return Tuple{X: x, Y: y, Z: z, W: w}
 }
 
 // CreatePoint creates a tuple designated as a point in order to talk
 // about a specific location in space
-func CreatePoint(x, y, z float64) *Tuple {
+func CreatePoint(x, y, z float64) Tuple {
 	return CreateTuple(x, y, z, 1.0)
 }
 
 // CreateVector creates a tuple designated as a vector. Same as point,
 // but this one refers to a point _and_ a direction.
-func CreateVector(x, y, z float64) *Tuple {
+func CreateVector(x, y, z float64) Tuple {
 	return CreateTuple(x, y, z, 0)
 }
 
@@ -132,49 +127,58 @@ func Equals(a, b float64) bool {
 // Magnitude calculates the total distance traveled by a vector.
 // if a non-vector is passed in, it returns a magnitude of zero.
 // uses pythagoras theorem: Sqrt(x^2 + y^2 + z^2)
-func Magnitude(vec *Tuple) float64 {
-	var aggregate float64
-
+//
+// Deprecated: Silently returns zero for points. Use Vector.Magnitude,
+// which only accepts vectors.
+func Magnitude(vec Tuple) float64 {
 	if vec.IsPoint() {
-		return aggregate
+		return 0
 	}
-
-	for _, val := range []float64{vec.X, vec.Y, vec.Z} {
-		aggregate += Calculate(val, val, Multiply)
-	}
-	return math.Sqrt(aggregate)
+	return math.Sqrt(vec.X*vec.X + vec.Y*vec.Y + vec.Z*vec.Z)
 }
 
 // NormalizeVector converts an arbitrary vector into a unit vector using
 // the vectors magnitude.
-func NormalizeVector(vec *Tuple) *Tuple {
+//
+// Deprecated: Divides by zero when given a point. Use Vector.Normalize,
+// which only accepts vectors.
+func NormalizeVector(vec Tuple) Tuple {
 	vectorMagnitude := Magnitude(vec)
 	return CreateVector(
-		Calculate(vec.X, vectorMagnitude, Divide),
-		Calculate(vec.Y, vectorMagnitude, Divide),
-		Calculate(vec.Z, vectorMagnitude, Divide),
+		vec.X/vectorMagnitude,
+		vec.Y/vectorMagnitude,
+		vec.Z/vectorMagnitude,
 	)
 }
 
 // DotProduct gets the dot product of two tuples.
-func DotProduct(a, b *Tuple) float64 {
-	return Calculate(a.X, b.X, Multiply) + Calculate(a.Y, b.Y, Multiply) + Calculate(a.Z, b.Z, Multiply) + Calculate(a.W, b.W, Multiply)
+func DotProduct(a, b Tuple) float64 {
+	return a.X*b.X + a.Y*b.Y + a.Z*b.Z + a.W*b.W
 }
 
 // CrossProduct gets the cross product of two vectors. Does not work with points.
 // Second return value will be true if a non-vector is passed to this function.
-func CrossProduct(a, b *Tuple) (*Tuple, bool) {
+//
+// Deprecated: Callers can ignore the flag and use the zero vector by
+// mistake. Use Vector.Cross, which only accepts vectors.
+func CrossProduct(a, b Tuple) (Tuple, bool) {
 	if a.IsPoint() || b.IsPoint() {
 		return CreateVector(0, 0, 0), true
 	}
 
 	return CreateVector(
-		Calculate(a.Y, b.Z, Multiply)-Calculate(a.Z, b.Y, Multiply),
-		Calculate(a.Z, b.X, Multiply)-Calculate(a.X, b.Z, Multiply),
-		Calculate(a.X, b.Y, Multiply)-Calculate(a.Y, b.X, Multiply),
+		a.Y*b.Z-a.Z*b.Y,
+		a.Z*b.X-a.X*b.Z,
+		a.X*b.Y-a.Y*b.X,
 	), false
 }
 
+// The functions and Calculate below do arithmetic using 200 bit precision
+// *big.Float values. They are far too slow to use while rendering, so every
+// tuple operation uses native float64 arithmetic instead. They are kept as
+// an opt-in for tests that want to verify results against a high precision
+// answer, either by calling Calculate or the Precise tuple functions.
+
 // Subtract function that holds onto the floating point substract function. Used
 // as an argument to the Calculate function.
 var Subtract func(a, b *big.Float) *big.Float = new(big.Float).Sub
@@ -191,8 +195,9 @@ var Multiply func(a, b *big.Float) *big.Float = new(big.Float).Mul
 // as an argument to the calculate function
 var Divide func(a, b *big.Float) *big.Float = new(big.Float).Quo
 
-// Calculate used for doing floating point arithmetic. Takes two operands
-// and an arithmetic function, returning the result as a parsed float64
+// Calculate used for doing arbitrary precision floating point arithmetic.
+// Takes two operands and an arithmetic function, returning the result as a
+// parsed float64. Only meant for verifying results in tests.
 func Calculate(a, b float64, arithmeticFn func(*big.Float, *big.Float) *big.Float) float64 {
 	left, success := new(big.Float).SetPrec(200).SetString(strconv.FormatFloat(a, 'f', -1, 64))
 	if success == false {
@@ -212,3 +217,56 @@ func Calculate(a, b float64, arithmeticFn func(*big.Float, *big.Float) *big.Floa
 
 	return parsed
 }
+
+// PreciseAdd works like (Tuple).Add but sums each component using Calculate.
+func PreciseAdd(a, b Tuple) Tuple {
+	return CreateTuple(
+		Calculate(a.X, b.X, Addition),
+		Calculate(a.Y, b.Y, Addition),
+		Calculate(a.Z, b.Z, Addition),
+		Calculate(a.W, b.W, Addition),
+	)
+}
+
+// PreciseSubtract works like (Tuple).Subtract but subtracts each component
+// using Calculate.
+func PreciseSubtract(a, b Tuple) Tuple {
+	return CreateTuple(
+		Calculate(a.X, b.X, Subtract),
+		Calculate(a.Y, b.Y, Subtract),
+		Calculate(a.Z, b.Z, Subtract),
+		Calculate(a.W, b.W, Subtract),
+	)
+}
+
+// PreciseMultiplyByScalar works like (Tuple).MultiplyByScalar but multiplies
+// each component using Calculate.
+func PreciseMultiplyByScalar(t Tuple, n float64) Tuple {
+	return CreateTuple(
+		Calculate(t.X, n, Multiply),
+		Calculate(t.Y, n, Multiply),
+		Calculate(t.Z, n, Multiply),
+		t.W,
+	)
+}
+
+// PreciseDivideByScalar works like (Tuple).DivideByScalar but divides each
+// component using Calculate.
+func PreciseDivideByScalar(t Tuple, n float64) Tuple {
+	return CreateTuple(
+		Calculate(t.X, n, Divide),
+		Calculate(t.Y, n, Divide),
+		Calculate(t.Z, n, Divide),
+		t.W,
+	)
+}
+
+// PreciseDotProduct works like DotProduct but multiplies and sums each
+// component using Calculate.
+func PreciseDotProduct(a, b Tuple) float64 {
+	sum := 0.0
+	for _, pair := range [][2]float64{{a.X, b.X}, {a.Y, b.Y}, {a.Z, b.Z}, {a.W, b.W}} {
+		sum = Calculate(sum, Calculate(pair[0], pair[1], Multiply), Addition)
+	}
+	return sum
+}
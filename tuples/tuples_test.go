@@ -104,12 +104,68 @@ func TestPointNotEqualToVector(t *testing.T) {
 
 func TestAddTwoTuplesTogether(t *testing.T) {
 	a := CreatePoint(4.2, 2.1, 1.9)
-	b := CreatePoint(-2.3, 4.7, 10.2)
+	b := CreateVector(-2.3, 4.7, 10.2)
 	want := CreatePoint(1.9, 6.8, 12.1)
 	got := a.Add(b)
 
 	if got.IsEquivalentTo(want) == false {
-		t.Errorf("Expected points to add together.\nGot %v;\nWant %v", got, want)
+		t.Errorf("Expected tuples to add together.\nGot %v;\nWant %v", got, want)
+	}
+}
+
+func TestAddVectorToPointIsPoint(t *testing.T) {
+	a := CreatePoint(3, -2, 5)
+	b := CreateVector(-2, 3, 1)
+
+	if got := a.Add(b); got.IsPoint() == false {
+		t.Errorf("Expected point from point + vector.\nGot %v", got)
+	}
+
+	if got := b.Add(a); got.IsPoint() == false {
+		t.Errorf("Expected point from vector + point.\nGot %v", got)
+	}
+}
+
+func TestAddVectorToVectorIsVector(t *testing.T) {
+	a := CreateVector(3, -2, 5)
+	b := CreateVector(-2, 3, 1)
+	got := a.Add(b)
+	want := CreateVector(1, 1, 6)
+
+	if got.IsVector() == false {
+		t.Errorf("Expected vector from vector + vector.\nGot %v", got)
+	}
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected vectors to add together.\nGot %v;\nWant %v", got, want)
+	}
+}
+
+func TestAddPointToPointIsNeitherPointNorVector(t *testing.T) {
+	a := CreatePoint(3, -2, 5)
+	b := CreatePoint(-2, 3, 1)
+	got := a.Add(b)
+
+	if got.IsPoint() || got.IsVector() {
+		t.Errorf("Expected point + point to be neither a point nor a vector.\nGot %v", got)
+	}
+
+	if got.W != 2 {
+		t.Errorf("Expected w components to be summed. Got %v; Want %v", got.W, 2)
+	}
+}
+
+func TestVectorSumsStayVectors(t *testing.T) {
+	gravity := CreateVector(0, -0.1, 0)
+	wind := CreateVector(-0.01, 0, 0)
+	velocity := NormalizeVector(CreateVector(1, 1, 0))
+
+	for i := 0; i < 10; i++ {
+		velocity = velocity.Add(gravity.Add(wind))
+	}
+
+	if velocity.IsVector() == false {
+		t.Errorf("Expected repeatedly adding vectors to stay a vector.\nGot %v", velocity)
 	}
 }
 
@@ -188,15 +244,23 @@ func TestSubtractVectorFromZeroVector(t *testing.T) {
 }
 
 func TestNegateTupleFunction(t *testing.T) {
-	orig := CreatePoint(3.2, -1.3, 5.4)
+	orig := CreateTuple(3.2, -1.3, 5.4, -2)
 	got := orig.Negate()
-	want := CreatePoint(-3.2, 1.3, -5.4)
+	want := CreateTuple(-3.2, 1.3, -5.4, 2)
 
 	if got.IsEquivalentTo(want) == false {
 		t.Errorf("Expected tuple to be negated.\nGot %v;\nWant %v", got, want)
 	}
 }
 
+func TestNegateVectorStaysVector(t *testing.T) {
+	got := CreateVector(1, -2, 3).Negate()
+
+	if got.IsVector() == false {
+		t.Errorf("Expected negated vector to stay a vector.\nGot %v", got)
+	}
+}
+
 func TestMultiplyFloatingPointNumbers(t *testing.T) {
 	got := Calculate(3, 7.5, Multiply)
 	want := 22.5
@@ -371,3 +435,109 @@ func TestInfinityFromCrossProductForNonVector(t *testing.T) {
 		t.Errorf("Expected truthy error when supplying `b` with a point. Vec is %v", vec)
 	}
 }
+
+func TestNativeArithmeticMatchesPreciseCalculation(t *testing.T) {
+	a := CreateVector(0.1, -4.3, 7.77)
+	b := CreateVector(2.2, 0.3, -1.01)
+
+	cases := []struct {
+		native  Tuple
+		precise Tuple
+	}{
+		{a.Add(b), PreciseAdd(a, b)},
+		{a.Subtract(b), PreciseSubtract(a, b)},
+		{a.MultiplyByScalar(3.3), PreciseMultiplyByScalar(a, 3.3)},
+		{a.DivideByScalar(0.7), PreciseDivideByScalar(a, 0.7)},
+	}
+
+	for _, tc := range cases {
+		if tc.native.IsEquivalentTo(tc.precise) == false {
+			t.Errorf("Expected native arithmetic to match precise calculation. Got %v; Want %v", tc.native, tc.precise)
+		}
+	}
+
+	if dot, want := DotProduct(a, b), PreciseDotProduct(a, b); Equals(dot, want) == false {
+		t.Errorf("Expected native dot product to match precise calculation. Got %v; Want %v", dot, want)
+	}
+}
+
+func TestPreciseArithmeticAvoidsRoundingError(t *testing.T) {
+	a := CreateVector(0.1, 0, 0)
+	b := CreateVector(0.2, 0, 0)
+
+	if got := a.Add(b); got.X == 0.3 {
+		t.Errorf("Expected native float arithmetic to have rounding error")
+	}
+
+	if got := PreciseAdd(a, b); got.X != 0.3 {
+		t.Errorf("Expected precise sum to be exact. Got %v", got)
+	}
+}
+
+func TestTupleOperationsDoNotAllocate(t *testing.T) {
+	a := CreateVector(1, 2, 3)
+	b := CreateVector(4, 5, 6)
+	var sink Tuple
+
+	allocs := testing.AllocsPerRun(100, func() {
+		sink = a.Add(b).Subtract(b).MultiplyByScalar(2).DivideByScalar(2).Negate()
+		sink, _ = CrossProduct(NormalizeVector(sink), b)
+	})
+
+	if allocs != 0 {
+		t.Errorf("Expected tuple operations to not allocate. Got %v allocations per run", allocs)
+	}
+}
+
+func BenchmarkAddTuples(b *testing.B) {
+	a := CreatePoint(1, 2, 3)
+	v := CreateVector(4, 5, 6)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		a = a.Add(v)
+	}
+}
+
+func BenchmarkSubtractTuples(b *testing.B) {
+	a := CreatePoint(1, 2, 3)
+	v := CreateVector(4, 5, 6)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		a = a.Subtract(v)
+	}
+}
+
+func BenchmarkMultiplyTupleByScalar(b *testing.B) {
+	v := CreateVector(1, 2, 3)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		v = v.MultiplyByScalar(1.0001)
+	}
+}
+
+func BenchmarkNormalizeVector(b *testing.B) {
+	v := CreateVector(1, 2, 3)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		v = NormalizeVector(v)
+	}
+}
+
+func BenchmarkDotProduct(b *testing.B) {
+	v := CreateVector(1, 2, 3)
+	w := CreateVector(4, 5, 6)
+	var sink float64
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sink += DotProduct(v, w)
+	}
+}
+
+func BenchmarkCrossProduct(b *testing.B) {
+	v := CreateVector(1, 2, 3)
+	w := CreateVector(4, 5, 6)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		v, _ = CrossProduct(v, w)
+	}
+}
@@ -0,0 +1,89 @@
+package tuples
+
+import "math"
+
+// Vector struct represents a direction and distance in space. Vectors
+// can be added to points and other vectors, but never produce a point
+// on their own.
+type Vector struct {
+	X float64
+	Y float64
+	Z float64
+}
+
+// Add sums the corresponding values of both vectors and returns the
+// resulting vector.
+func (v Vector) Add(b Vector) Vector {
+	return NewVector(v.X+b.X, v.Y+b.Y, v.Z+b.Z)
+}
+
+// Subtract takes the corresponding values of both vectors and subtracts
+// them, returning the resulting vector.
+func (v Vector) Subtract(b Vector) Vector {
+	return NewVector(v.X-b.X, v.Y-b.Y, v.Z-b.Z)
+}
+
+// Negate returns the vector pointing in the opposite direction.
+func (v Vector) Negate() Vector {
+	return NewVector(-v.X, -v.Y, -v.Z)
+}
+
+// MultiplyByScalar multiplies each component of the vector by the given
+// scalar, returning the resulting vector.
+func (v Vector) MultiplyByScalar(n float64) Vector {
+	return NewVector(v.X*n, v.Y*n, v.Z*n)
+}
+
+// DivideByScalar divides each component of the vector by the given
+// scalar, returning the resulting vector.
+func (v Vector) DivideByScalar(n float64) Vector {
+	return NewVector(v.X/n, v.Y/n, v.Z/n)
+}
+
+// Magnitude calculates the length of the vector.
+func (v Vector) Magnitude() float64 {
+	return math.Sqrt(v.X*v.X + v.Y*v.Y + v.Z*v.Z)
+}
+
+// Normalize converts the vector into a unit vector pointing in the
+// same direction.
+func (v Vector) Normalize() Vector {
+	return v.DivideByScalar(v.Magnitude())
+}
+
+// Dot gets the dot product of the calling vector and the given vector.
+func (v Vector) Dot(b Vector) float64 {
+	return v.X*b.X + v.Y*b.Y + v.Z*b.Z
+}
+
+// Cross gets the cross product of the calling vector and the given vector.
+// The result is perpendicular to both vectors.
+func (v Vector) Cross(b Vector) Vector {
+	return NewVector(
+		v.Y*b.Z-v.Z*b.Y,
+		v.Z*b.X-v.X*b.Z,
+		v.X*b.Y-v.Y*b.X,
+	)
+}
+
+// Reflect bounces the vector off a surface with the given normal, returning
+// the reflected vector.
+func (v Vector) Reflect(normal Vector) Vector {
+	return v.Subtract(normal.MultiplyByScalar(2 * v.Dot(normal)))
+}
+
+// IsEquivalentTo checks if each component of the given vector is
+// equivalent to the calling vector.
+func (v Vector) IsEquivalentTo(b Vector) bool {
+	return Equals(v.X, b.X) && Equals(v.Y, b.Y) && Equals(v.Z, b.Z)
+}
+
+// ToTuple converts the vector into a tuple with a w of 0.
+func (v Vector) ToTuple() Tuple {
+	return CreateVector(v.X, v.Y, v.Z)
+}
+
+// NewVector creates a vector with the given components.
+func NewVector(x, y, z float64) Vector {
+	return Vector{X: x, Y: y, Z: z}
+}
@@ -0,0 +1,135 @@
+package tuples
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAddTwoVectorTypes(t *testing.T) {
+	a := NewVector(3, -2, 5)
+	b := NewVector(-2, 3, 1)
+	got := a.Add(b)
+	want := NewVector(1, 1, 6)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected vector from vector + vector.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestSubtractTwoVectorTypes(t *testing.T) {
+	a := NewVector(3, 2, 1)
+	b := NewVector(5, 6, 7)
+	got := a.Subtract(b)
+	want := NewVector(-2, -4, -6)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected vector from vector - vector.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestNegateVectorType(t *testing.T) {
+	got := NewVector(1, -2, 3).Negate()
+	want := NewVector(-1, 2, -3)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected negated vector.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestScaleVectorType(t *testing.T) {
+	v := NewVector(1, -2, 3)
+
+	if got, want := v.MultiplyByScalar(3.5), NewVector(3.5, -7, 10.5); got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected vector multiplied by scalar.\nGot  %v;\nWant %v;", got, want)
+	}
+
+	if got, want := v.DivideByScalar(2), NewVector(0.5, -1, 1.5); got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected vector divided by scalar.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestMagnitudeOfVectorType(t *testing.T) {
+	got := NewVector(-1, -2, -3).Magnitude()
+	want := math.Sqrt(14)
+
+	if Equals(got, want) == false {
+		t.Errorf("Expected magnitude of vector. Got %v; Want %v", got, want)
+	}
+}
+
+func TestNormalizeVectorType(t *testing.T) {
+	got := NewVector(1, 2, 3).Normalize()
+	want := NewVector(0.26726, 0.53452, 0.80178)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected normalized vector.\nGot  %v;\nWant %v;", got, want)
+	}
+
+	if Equals(got.Magnitude(), 1) == false {
+		t.Errorf("Expected normalized vector to have magnitude of one. Got %v", got.Magnitude())
+	}
+}
+
+func TestDotProductOfVectorTypes(t *testing.T) {
+	got := NewVector(1, 2, 3).Dot(NewVector(2, 3, 4))
+	want := 20.0
+
+	if Equals(got, want) == false {
+		t.Errorf("Expected dot product to be %v. Got %v", want, got)
+	}
+}
+
+func TestCrossProductOfVectorTypes(t *testing.T) {
+	a := NewVector(1, 2, 3)
+	b := NewVector(2, 3, 4)
+
+	if got, want := a.Cross(b), NewVector(-1, 2, -1); got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected cross product of a and b.\nGot  %v;\nWant %v;", got, want)
+	}
+
+	if got, want := b.Cross(a), NewVector(1, -2, 1); got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected cross product of b and a.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestVectorToTupleAndBack(t *testing.T) {
+	v := NewVector(1, 2, 3)
+	tup := v.ToTuple()
+
+	if tup.IsVector() == false {
+		t.Errorf("Expected vector to convert into a tuple with w of 0. Got %v", tup)
+	}
+
+	got, ok := tup.ToVector()
+	if ok == false || got.IsEquivalentTo(v) == false {
+		t.Errorf("Expected tuple to convert back into the same vector.\nGot  %v;\nWant %v;", got, v)
+	}
+}
+
+func TestPointTupleIsNotAVector(t *testing.T) {
+	if _, ok := CreatePoint(1, 2, 3).ToVector(); ok {
+		t.Errorf("Expected converting a point tuple into a vector to fail")
+	}
+}
+
+func TestReflectVectorApproachingAt45Degrees(t *testing.T) {
+	v := NewVector(1, -1, 0)
+	n := NewVector(0, 1, 0)
+	got := v.Reflect(n)
+	want := NewVector(1, 1, 0)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected vector reflected off flat surface.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestReflectVectorOffSlantedSurface(t *testing.T) {
+	v := NewVector(0, -1, 0)
+	n := NewVector(math.Sqrt2/2, math.Sqrt2/2, 0)
+	got := v.Reflect(n)
+	want := NewVector(1, 0, 0)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected vector reflected off slanted surface.\nGot  %v;\nWant %v;", got, want)
+	}
+}
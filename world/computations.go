@@ -0,0 +1,46 @@
+package world
+
+import (
+	"github.com/riavalon/ray_tracer/rays"
+	"github.com/riavalon/ray_tracer/shapes"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+// Computations struct holds the values needed to shade a hit that can be
+// calculated up front from the intersection and the ray.
+type Computations struct {
+	T            float64
+	Object       shapes.Shape
+	Point        tuples.Point
+	OverPoint    tuples.Point
+	EyeVector    tuples.Vector
+	NormalVector tuples.Vector
+	Inside       bool
+}
+
+// PrepareComputations finds the point in world space where the intersection
+// happened along with the eye and normal vectors at that point. If the hit
+// is on the inside of the object, the normal is flipped so it points back
+// towards the eye. OverPoint is nudged slightly along the normal so that it
+// sits just above the surface.
+func PrepareComputations(hit shapes.Intersection, r rays.Ray) Computations {
+	point := r.Position(hit.T)
+	eye := r.Direction.Negate()
+	normal := shapes.NormalAt(hit.Object, point, hit)
+
+	inside := false
+	if normal.Dot(eye) < 0 {
+		inside = true
+		normal = normal.Negate()
+	}
+
+	return Computations{
+		T:            hit.T,
+		Object:       hit.Object,
+		Point:        point,
+		OverPoint:    point.Add(normal.MultiplyByScalar(tuples.EPSILON)),
+		EyeVector:    eye,
+		NormalVector: normal,
+		Inside:       inside,
+	}
+}
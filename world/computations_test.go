@@ -0,0 +1,97 @@
+package world
+
+import (
+	"testing"
+
+	"github.com/riavalon/ray_tracer/matrix"
+	"github.com/riavalon/ray_tracer/rays"
+	"github.com/riavalon/ray_tracer/shapes"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+func TestPrepareComputations(t *testing.T) {
+	r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1))
+	s := shapes.NewSphere()
+	i := shapes.NewIntersection(4, s)
+	comps := PrepareComputations(i, r)
+
+	if comps.T != i.T {
+		t.Errorf("Expected computations to copy t. Got %v; Want %v", comps.T, i.T)
+	}
+
+	if comps.Object != s {
+		t.Errorf("Expected computations to copy object. Got %v; Want %v", comps.Object, s)
+	}
+
+	if want := tuples.NewPoint(0, 0, -1); comps.Point.IsEquivalentTo(want) == false {
+		t.Errorf("Expected point of intersection. Got %v; Want %v", comps.Point, want)
+	}
+
+	if want := tuples.NewVector(0, 0, -1); comps.EyeVector.IsEquivalentTo(want) == false {
+		t.Errorf("Expected eye vector. Got %v; Want %v", comps.EyeVector, want)
+	}
+
+	if want := tuples.NewVector(0, 0, -1); comps.NormalVector.IsEquivalentTo(want) == false {
+		t.Errorf("Expected normal vector. Got %v; Want %v", comps.NormalVector, want)
+	}
+}
+
+func TestHitOnOutsideOfObject(t *testing.T) {
+	r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1))
+	comps := PrepareComputations(shapes.NewIntersection(4, shapes.NewSphere()), r)
+
+	if comps.Inside {
+		t.Errorf("Expected hit to be on the outside of the object")
+	}
+}
+
+func TestHitOnInsideOfObject(t *testing.T) {
+	r := rays.NewRay(tuples.NewPoint(0, 0, 0), tuples.NewVector(0, 0, 1))
+	comps := PrepareComputations(shapes.NewIntersection(1, shapes.NewSphere()), r)
+
+	if comps.Inside == false {
+		t.Errorf("Expected hit to be on the inside of the object")
+	}
+
+	if want := tuples.NewPoint(0, 0, 1); comps.Point.IsEquivalentTo(want) == false {
+		t.Errorf("Expected point of intersection. Got %v; Want %v", comps.Point, want)
+	}
+
+	if want := tuples.NewVector(0, 0, -1); comps.NormalVector.IsEquivalentTo(want) == false {
+		t.Errorf("Expected normal to be flipped towards the eye. Got %v; Want %v", comps.NormalVector, want)
+	}
+}
+
+func TestHitOffsetsOverPoint(t *testing.T) {
+	r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1))
+	s := shapes.NewSphere()
+	s.SetTransform(matrix.Translation(0, 0, 1))
+	comps := PrepareComputations(shapes.NewIntersection(5, s), r)
+
+	if comps.OverPoint.Z >= -tuples.EPSILON/2 {
+		t.Errorf("Expected over point to sit above the surface. Got %v", comps.OverPoint.Z)
+	}
+
+	if comps.Point.Z <= comps.OverPoint.Z {
+		t.Errorf("Expected point to be below over point. Got %v; Over point %v", comps.Point.Z, comps.OverPoint.Z)
+	}
+}
+
+func TestPrepareComputationsWithSmoothTriangle(t *testing.T) {
+	tri := shapes.NewSmoothTriangle(
+		tuples.NewPoint(0, 1, 0),
+		tuples.NewPoint(-1, 0, 0),
+		tuples.NewPoint(1, 0, 0),
+		tuples.NewVector(0, 1, 0),
+		tuples.NewVector(-1, 0, 0),
+		tuples.NewVector(1, 0, 0),
+	)
+	r := rays.NewRay(tuples.NewPoint(-0.2, 0.3, -2), tuples.NewVector(0, 0, 1))
+	i := shapes.NewIntersectionWithUV(1, tri, 0.45, 0.25)
+	comps := PrepareComputations(i, r)
+	want := tuples.NewVector(-0.5547, 0.83205, 0)
+
+	if comps.NormalVector.IsEquivalentTo(want) == false {
+		t.Errorf("Expected normal to be interpolated from hit.\nGot  %v;\nWant %v;", comps.NormalVector, want)
+	}
+}
@@ -0,0 +1,69 @@
+package world
+
+import (
+	"github.com/riavalon/ray_tracer/canvas"
+	"github.com/riavalon/ray_tracer/lights"
+	"github.com/riavalon/ray_tracer/rays"
+	"github.com/riavalon/ray_tracer/shapes"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+// World struct holds every object and light source in a scene.
+type World struct {
+	Objects []shapes.Shape
+	Lights  []lights.PointLight
+}
+
+// IntersectWorld intersects the ray with every object in the world,
+// returning all of the intersections sorted by t.
+func (w World) IntersectWorld(r rays.Ray) shapes.Intersections {
+	var xs []shapes.Intersection
+	for _, object := range w.Objects {
+		xs = append(xs, shapes.Intersect(object, r)...)
+	}
+	return shapes.NewIntersections(xs...)
+}
+
+// ShadeHit calculates the colour at the hit described by the computations,
+// adding together the contribution of every light in the world. Shadows
+// are checked from the over point so the surface does not shadow itself.
+func (w World) ShadeHit(comps Computations) canvas.Colour {
+	colour := canvas.NewColour(0, 0, 0)
+	material := comps.Object.Material()
+	for _, light := range w.Lights {
+		inShadow := w.IsShadowed(comps.OverPoint, light)
+		colour = colour.Add(lights.Lighting(material, light, comps.OverPoint, comps.EyeVector, comps.NormalVector, inShadow))
+	}
+	return colour
+}
+
+// IsShadowed checks if any object sits between the point and the light.
+// Objects whose material has NoShadow set are ignored.
+func (w World) IsShadowed(point tuples.Point, light lights.PointLight) bool {
+	toLight := light.Position.Subtract(point)
+	distance := toLight.Magnitude()
+	r := rays.NewRay(point, toLight.Normalize())
+
+	for _, x := range w.IntersectWorld(r) {
+		if x.T < 0 || x.Object.Material().NoShadow {
+			continue
+		}
+		return x.T < distance
+	}
+	return false
+}
+
+// ColourAt casts the ray into the world and returns the colour of whatever
+// it hits. Returns black if the ray does not hit anything.
+func (w World) ColourAt(r rays.Ray) canvas.Colour {
+	hit, ok := w.IntersectWorld(r).Hit()
+	if !ok {
+		return canvas.NewColour(0, 0, 0)
+	}
+	return w.ShadeHit(PrepareComputations(hit, r))
+}
+
+// NewWorld creates an empty world with no objects or lights.
+func NewWorld() World {
+	return World{}
+}
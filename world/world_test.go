@@ -0,0 +1,214 @@
+package world
+
+import (
+	"testing"
+
+	"github.com/riavalon/ray_tracer/canvas"
+	"github.com/riavalon/ray_tracer/lights"
+	"github.com/riavalon/ray_tracer/matrix"
+	"github.com/riavalon/ray_tracer/rays"
+	"github.com/riavalon/ray_tracer/shapes"
+	tuples "github.com/riavalon/ray_tracer/tuples"
+)
+
+// defaultWorld builds a world with a light and two concentric spheres
+// which most of the tests below shoot rays at.
+func defaultWorld() World {
+	outer := shapes.NewSphere()
+	m := outer.Material()
+	m.Colour = canvas.NewColour(0.8, 1.0, 0.6)
+	m.Diffuse = 0.7
+	m.Specular = 0.2
+	outer.SetMaterial(m)
+
+	inner := shapes.NewSphere()
+	inner.SetTransform(matrix.Scaling(0.5, 0.5, 0.5))
+
+	w := NewWorld()
+	w.Objects = []shapes.Shape{outer, inner}
+	w.Lights = []lights.PointLight{
+		lights.NewPointLight(tuples.NewPoint(-10, 10, -10), canvas.NewColour(1, 1, 1)),
+	}
+	return w
+}
+
+func TestNewWorldIsEmpty(t *testing.T) {
+	w := NewWorld()
+
+	if len(w.Objects) != 0 || len(w.Lights) != 0 {
+		t.Errorf("Expected new world to have no objects or lights. Got %v", w)
+	}
+}
+
+func TestIntersectWorldWithRay(t *testing.T) {
+	w := defaultWorld()
+	r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1))
+	xs := w.IntersectWorld(r)
+	want := []float64{4, 4.5, 5.5, 6}
+
+	if len(xs) != len(want) {
+		t.Fatalf("Expected %v intersections. Got %v", len(want), len(xs))
+	}
+
+	for i, x := range xs {
+		if x.T != want[i] {
+			t.Errorf("Expected sorted intersections. Got %v at %v; Want %v", x.T, i, want[i])
+		}
+	}
+}
+
+func TestShadeIntersection(t *testing.T) {
+	w := defaultWorld()
+	r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1))
+	i := shapes.NewIntersection(4, w.Objects[0])
+	got := w.ShadeHit(PrepareComputations(i, r))
+	want := canvas.NewColour(0.38066, 0.47583, 0.2855)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected shaded colour of outer sphere.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestShadeIntersectionFromInside(t *testing.T) {
+	w := defaultWorld()
+	w.Lights = []lights.PointLight{
+		lights.NewPointLight(tuples.NewPoint(0, 0.25, 0), canvas.NewColour(1, 1, 1)),
+	}
+	r := rays.NewRay(tuples.NewPoint(0, 0, 0), tuples.NewVector(0, 0, 1))
+	i := shapes.NewIntersection(0.5, w.Objects[1])
+	got := w.ShadeHit(PrepareComputations(i, r))
+	want := canvas.NewColour(0.90498, 0.90498, 0.90498)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected shaded colour from inside inner sphere.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestShadeHitWithMultipleLights(t *testing.T) {
+	w := defaultWorld()
+	r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1))
+	i := shapes.NewIntersection(4, w.Objects[0])
+	single := w.ShadeHit(PrepareComputations(i, r))
+
+	w.Lights = append(w.Lights, w.Lights[0])
+	got := w.ShadeHit(PrepareComputations(i, r))
+	want := single.MultiplyByScalar(2)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected every light to contribute to the colour.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestColourWhenRayMisses(t *testing.T) {
+	w := defaultWorld()
+	r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 1, 0))
+	got := w.ColourAt(r)
+	want := canvas.NewColour(0, 0, 0)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected black when ray misses.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestColourWhenRayHits(t *testing.T) {
+	w := defaultWorld()
+	r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1))
+	got := w.ColourAt(r)
+	want := canvas.NewColour(0.38066, 0.47583, 0.2855)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected colour of outer sphere when ray hits.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestColourWithIntersectionBehindRay(t *testing.T) {
+	w := defaultWorld()
+	outer, inner := w.Objects[0], w.Objects[1]
+
+	m := outer.Material()
+	m.Ambient = 1
+	outer.SetMaterial(m)
+
+	m = inner.Material()
+	m.Ambient = 1
+	inner.SetMaterial(m)
+
+	r := rays.NewRay(tuples.NewPoint(0, 0, 0.75), tuples.NewVector(0, 0, -1))
+	got := w.ColourAt(r)
+	want := inner.Material().Colour
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected colour of inner sphere.\nGot  %v;\nWant %v;", got, want)
+	}
+}
+
+func TestNoShadowWhenNothingIsBetweenPointAndLight(t *testing.T) {
+	w := defaultWorld()
+	p := tuples.NewPoint(0, 10, 0)
+
+	if w.IsShadowed(p, w.Lights[0]) {
+		t.Errorf("Expected no shadow when nothing is collinear with point and light")
+	}
+}
+
+func TestShadowWhenObjectIsBetweenPointAndLight(t *testing.T) {
+	w := defaultWorld()
+	p := tuples.NewPoint(10, -10, 10)
+
+	if w.IsShadowed(p, w.Lights[0]) == false {
+		t.Errorf("Expected shadow when an object is between the point and light")
+	}
+}
+
+func TestNoShadowWhenObjectIsBehindLight(t *testing.T) {
+	w := defaultWorld()
+	p := tuples.NewPoint(-20, 20, -20)
+
+	if w.IsShadowed(p, w.Lights[0]) {
+		t.Errorf("Expected no shadow when an object is behind the light")
+	}
+}
+
+func TestNoShadowWhenObjectIsBehindPoint(t *testing.T) {
+	w := defaultWorld()
+	p := tuples.NewPoint(-2, 2, -2)
+
+	if w.IsShadowed(p, w.Lights[0]) {
+		t.Errorf("Expected no shadow when an object is behind the point")
+	}
+}
+
+func TestNoShadowFromObjectThatOptsOut(t *testing.T) {
+	w := defaultWorld()
+	for _, object := range w.Objects {
+		m := object.Material()
+		m.NoShadow = true
+		object.SetMaterial(m)
+	}
+	p := tuples.NewPoint(10, -10, 10)
+
+	if w.IsShadowed(p, w.Lights[0]) {
+		t.Errorf("Expected objects with NoShadow to not cast shadows")
+	}
+}
+
+func TestShadeHitInShadow(t *testing.T) {
+	first := shapes.NewSphere()
+	second := shapes.NewSphere()
+	second.SetTransform(matrix.Translation(0, 0, 10))
+
+	w := NewWorld()
+	w.Objects = []shapes.Shape{first, second}
+	w.Lights = []lights.PointLight{
+		lights.NewPointLight(tuples.NewPoint(0, 0, -10), canvas.NewColour(1, 1, 1)),
+	}
+
+	r := rays.NewRay(tuples.NewPoint(0, 0, 5), tuples.NewVector(0, 0, 1))
+	i := shapes.NewIntersection(4, second)
+	got := w.ShadeHit(PrepareComputations(i, r))
+	want := canvas.NewColour(0.1, 0.1, 0.1)
+
+	if got.IsEquivalentTo(want) == false {
+		t.Errorf("Expected only ambient light for hit in shadow.\nGot  %v;\nWant %v;", got, want)
+	}
+}